@@ -0,0 +1,57 @@
+package calculator
+
+import "sync"
+
+// Accumulator keeps a running total fed over time, rounded at the precision
+// of the Calculator that created it. Unlike Chain it is long-lived, does not
+// record history and is safe for concurrent use.
+type Accumulator struct {
+	calc  *Calculator
+	mu    sync.Mutex
+	total float64
+	count int
+}
+
+// NewAccumulator returns an empty Accumulator that rounds like c
+func (c *Calculator) NewAccumulator() *Accumulator {
+	return &Accumulator{calc: c}
+}
+
+// Add adds x to the running total
+func (a *Accumulator) Add(x float64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.total = a.calc.round(a.total + x)
+	a.count++
+}
+
+// Subtract subtracts x from the running total
+func (a *Accumulator) Subtract(x float64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.total = a.calc.round(a.total - x)
+	a.count++
+}
+
+// Value returns the running total
+func (a *Accumulator) Value() float64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.total
+}
+
+// Count returns how many values have been added or subtracted since the
+// last Reset
+func (a *Accumulator) Count() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.count
+}
+
+// Reset sets the running total and count back to zero
+func (a *Accumulator) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.total = 0
+	a.count = 0
+}
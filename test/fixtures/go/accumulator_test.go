@@ -0,0 +1,45 @@
+package calculator
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccumulator(t *testing.T) {
+	acc := NewCalculator().NewAccumulator()
+	for _, x := range []float64{0.1, 0.2, 1.5, 2.25} {
+		acc.Add(x)
+	}
+	acc.Subtract(1)
+	assert.Equal(t, 3.05, acc.Value())
+	assert.Equal(t, 5, acc.Count())
+
+	acc.Reset()
+	assert.Equal(t, 0.0, acc.Value())
+	assert.Equal(t, 0, acc.Count())
+}
+
+func TestAccumulatorInheritsPrecision(t *testing.T) {
+	acc := NewCalculator(WithPrecision(0)).NewAccumulator()
+	acc.Add(1.4)
+	acc.Add(1.4)
+	assert.Equal(t, 2.0, acc.Value())
+}
+
+func TestAccumulatorConcurrent(t *testing.T) {
+	acc := NewCalculator().NewAccumulator()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			acc.Add(2)
+			acc.Subtract(1)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 50.0, acc.Value())
+	assert.Equal(t, 100, acc.Count())
+}
@@ -0,0 +1,12 @@
+package calculator
+
+// Arithmetic is the basic four-function surface of Calculator, for callers
+// that want to depend on an interface and substitute a mock or adapter
+type Arithmetic interface {
+	Add(a, b float64) float64
+	Subtract(a, b float64) float64
+	Multiply(a, b float64) float64
+	Divide(a, b float64) (float64, error)
+}
+
+var _ Arithmetic = (*Calculator)(nil)
@@ -0,0 +1,54 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubArithmetic records calls and returns fixed results
+type stubArithmetic struct {
+	calls []string
+}
+
+func (s *stubArithmetic) Add(a, b float64) float64 {
+	s.calls = append(s.calls, "Add")
+	return 1
+}
+
+func (s *stubArithmetic) Subtract(a, b float64) float64 {
+	s.calls = append(s.calls, "Subtract")
+	return 2
+}
+
+func (s *stubArithmetic) Multiply(a, b float64) float64 {
+	s.calls = append(s.calls, "Multiply")
+	return 3
+}
+
+func (s *stubArithmetic) Divide(a, b float64) (float64, error) {
+	s.calls = append(s.calls, "Divide")
+	return 4, nil
+}
+
+var _ Arithmetic = (*stubArithmetic)(nil)
+
+// mean is a caller that only depends on the interface
+func mean(arith Arithmetic, a, b float64) (float64, error) {
+	return arith.Divide(arith.Add(a, b), 2)
+}
+
+func TestArithmeticStub(t *testing.T) {
+	stub := &stubArithmetic{}
+	result, err := mean(stub, 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, 4.0, result)
+	assert.Equal(t, []string{"Add", "Divide"}, stub.calls)
+}
+
+func TestArithmeticCalculator(t *testing.T) {
+	result, err := mean(NewCalculator(), 10, 21)
+	require.NoError(t, err)
+	assert.Equal(t, 15.5, result)
+}
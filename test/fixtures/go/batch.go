@@ -0,0 +1,45 @@
+package calculator
+
+import "fmt"
+
+// Op is a single step applied by ApplyOps. Kind is one of "add", "sub",
+// "mul", "div", "mod" or "pow".
+type Op struct {
+	Kind    string
+	Operand float64
+}
+
+// ApplyOps folds ops left to right over a running value that starts at start.
+// The first failing step stops the fold and its index is reported in the error.
+func (c *Calculator) ApplyOps(start float64, ops []Op) (float64, error) {
+	if err := c.checkOperands(start); err != nil {
+		return 0, err
+	}
+	value := start
+	for i, op := range ops {
+		err := c.checkOperands(op.Operand)
+		if err != nil {
+			return 0, fmt.Errorf("op %d: %w", i, err)
+		}
+		switch op.Kind {
+		case "add":
+			value = c.Add(value, op.Operand)
+		case "sub":
+			value = c.Subtract(value, op.Operand)
+		case "mul":
+			value = c.Multiply(value, op.Operand)
+		case "div":
+			value, err = c.Divide(value, op.Operand)
+		case "mod":
+			value, err = c.Modulo(value, op.Operand)
+		case "pow":
+			value, err = c.Power(value, op.Operand)
+		default:
+			err = fmt.Errorf("%w %q", ErrUnknownOperation, op.Kind)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("op %d: %w", i, err)
+		}
+	}
+	return value, nil
+}
@@ -0,0 +1,50 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyOps(t *testing.T) {
+	calc := NewCalculator()
+	ops := []Op{
+		{Kind: "add", Operand: 5},
+		{Kind: "mul", Operand: 3},
+		{Kind: "sub", Operand: 1},
+		{Kind: "div", Operand: 4},
+		{Kind: "pow", Operand: 2},
+		{Kind: "mod", Operand: 10},
+	}
+	result, err := calc.ApplyOps(2, ops)
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, result)
+}
+
+func TestApplyOpsEmpty(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.ApplyOps(1.5, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, result)
+}
+
+func TestApplyOpsErrorReportsIndex(t *testing.T) {
+	calc := NewCalculator()
+	ops := []Op{
+		{Kind: "add", Operand: 1},
+		{Kind: "div", Operand: 0},
+		{Kind: "mul", Operand: 2},
+	}
+	_, err := calc.ApplyOps(10, ops)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	assert.Contains(t, err.Error(), "op 1")
+}
+
+func TestApplyOpsUnknownKind(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.ApplyOps(10, []Op{{Kind: "add", Operand: 1}, {Kind: "sqrt"}})
+	assert.ErrorIs(t, err, ErrUnknownOperation)
+	assert.Contains(t, err.Error(), `op 1`)
+	assert.Contains(t, err.Error(), `"sqrt"`)
+}
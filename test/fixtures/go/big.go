@@ -0,0 +1,82 @@
+package calculator
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// DefaultBigPrecision is the mantissa size in bits used by NewBigCalculator(0)
+const DefaultBigPrecision = 256
+
+// BigCalculator performs arbitrary-precision arithmetic backed by big.Float.
+// It avoids the drift that accumulates when summing many float64 values.
+type BigCalculator struct {
+	prec uint
+}
+
+// NewBigCalculator creates a calculator whose results carry the given number
+// of mantissa bits, or DefaultBigPrecision when bits is 0
+func NewBigCalculator(bits uint) *BigCalculator {
+	if bits == 0 {
+		bits = DefaultBigPrecision
+	}
+	return &BigCalculator{prec: bits}
+}
+
+// Precision returns the mantissa size in bits
+func (bc *BigCalculator) Precision() uint {
+	return bc.prec
+}
+
+// Parse converts a decimal string such as "0.1" exactly to the calculator's precision
+func (bc *BigCalculator) Parse(s string) (*big.Float, error) {
+	f, _, err := new(big.Float).SetPrec(bc.prec).Parse(s, 10)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q: %w", s, err)
+	}
+	return f, nil
+}
+
+// Add returns the sum of two numbers
+func (bc *BigCalculator) Add(a, b *big.Float) (*big.Float, error) {
+	return bc.do(func(z *big.Float) { z.Add(a, b) })
+}
+
+// Subtract returns the difference of two numbers
+func (bc *BigCalculator) Subtract(a, b *big.Float) (*big.Float, error) {
+	return bc.do(func(z *big.Float) { z.Sub(a, b) })
+}
+
+// Multiply returns the product of two numbers
+func (bc *BigCalculator) Multiply(a, b *big.Float) (*big.Float, error) {
+	return bc.do(func(z *big.Float) { z.Mul(a, b) })
+}
+
+// Divide returns the quotient of two numbers
+func (bc *BigCalculator) Divide(a, b *big.Float) (*big.Float, error) {
+	if b.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	return bc.do(func(z *big.Float) { z.Quo(a, b) })
+}
+
+// Format returns x as a decimal string rounded to the given number of places
+func (bc *BigCalculator) Format(x *big.Float, places int) string {
+	return x.Text('f', places)
+}
+
+// do runs op on a fresh result, turning big.Float's ErrNaN panic
+// (e.g. from Inf - Inf) into ErrNotFinite
+func (bc *BigCalculator) do(op func(z *big.Float)) (result *big.Float, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(big.ErrNaN); !ok {
+				panic(r)
+			}
+			result, err = nil, ErrNotFinite
+		}
+	}()
+	z := new(big.Float).SetPrec(bc.prec)
+	op(z)
+	return z, nil
+}
@@ -0,0 +1,68 @@
+package calculator
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBigCalculatorSumIsExact(t *testing.T) {
+	bc := NewBigCalculator(0)
+	tenth, err := bc.Parse("0.1")
+	require.NoError(t, err)
+
+	total := new(big.Float)
+	floatTotal := 0.0
+	for i := 0; i < 1000; i++ {
+		total, err = bc.Add(total, tenth)
+		require.NoError(t, err)
+		floatTotal += 0.1
+	}
+
+	assert.Equal(t, "100.00", bc.Format(total, 2))
+	assert.NotEqual(t, 100.0, floatTotal)
+}
+
+func TestBigCalculatorOperations(t *testing.T) {
+	bc := NewBigCalculator(128)
+	a, b := big.NewFloat(7.5), big.NewFloat(2.5)
+
+	result, err := bc.Add(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, "10.00", bc.Format(result, 2))
+
+	result, err = bc.Subtract(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, "5.00", bc.Format(result, 2))
+
+	result, err = bc.Multiply(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, "18.75", bc.Format(result, 2))
+
+	result, err = bc.Divide(big.NewFloat(1), big.NewFloat(3))
+	require.NoError(t, err)
+	assert.Equal(t, "0.3333", bc.Format(result, 4))
+	assert.Equal(t, uint(128), result.Prec())
+}
+
+func TestBigCalculatorDivideByZero(t *testing.T) {
+	bc := NewBigCalculator(0)
+	_, err := bc.Divide(big.NewFloat(1), new(big.Float))
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestBigCalculatorNaN(t *testing.T) {
+	bc := NewBigCalculator(0)
+	inf := big.NewFloat(math.Inf(1))
+	_, err := bc.Subtract(inf, inf)
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestBigCalculatorParseInvalid(t *testing.T) {
+	bc := NewBigCalculator(0)
+	_, err := bc.Parse("twelve")
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+package calculator
+
+import "fmt"
+
+// And returns the bitwise AND of a and b
+func (c *Calculator) And(a, b int) int {
+	return a & b
+}
+
+// Or returns the bitwise OR of a and b
+func (c *Calculator) Or(a, b int) int {
+	return a | b
+}
+
+// Xor returns the bitwise exclusive OR of a and b
+func (c *Calculator) Xor(a, b int) int {
+	return a ^ b
+}
+
+// ShiftLeft returns a shifted left by n bits. Bits shifted past the top are
+// discarded, as with Go's << operator. A negative n is an error.
+func (c *Calculator) ShiftLeft(a, n int) (int, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("%w: negative shift count %d", ErrInvalidArgument, n)
+	}
+	return a << n, nil
+}
+
+// ShiftRight returns a shifted right by n bits, preserving the sign as with
+// Go's >> operator. A negative n is an error.
+func (c *Calculator) ShiftRight(a, n int) (int, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("%w: negative shift count %d", ErrInvalidArgument, n)
+	}
+	return a >> n, nil
+}
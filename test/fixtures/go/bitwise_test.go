@@ -0,0 +1,42 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitwise(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 2, calc.And(6, 3))
+	assert.Equal(t, 7, calc.Or(6, 3))
+	assert.Equal(t, 5, calc.Xor(6, 3))
+}
+
+func TestShift(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.ShiftLeft(1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 16, result)
+
+	result, err = calc.ShiftRight(16, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result)
+
+	result, err = calc.ShiftRight(-16, 2)
+	require.NoError(t, err)
+	assert.Equal(t, -4, result)
+
+	result, err = calc.ShiftLeft(1, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result)
+}
+
+func TestShiftNegativeCount(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.ShiftLeft(1, -1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = calc.ShiftRight(1, -1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
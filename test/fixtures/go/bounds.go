@@ -0,0 +1,50 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// Min returns the smallest of values. Like math.Min, a NaN in values makes the result NaN.
+func (c *Calculator) Min(values ...float64) (float64, error) {
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	result := values[0]
+	for _, v := range values[1:] {
+		result = math.Min(result, v)
+	}
+	return c.round(result), nil
+}
+
+// Max returns the largest of values. Like math.Max, a NaN in values makes the result NaN.
+func (c *Calculator) Max(values ...float64) (float64, error) {
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	result := values[0]
+	for _, v := range values[1:] {
+		result = math.Max(result, v)
+	}
+	return c.round(result), nil
+}
+
+// Clamp bounds value to [low, high]. A NaN value is returned as NaN.
+func (c *Calculator) Clamp(value, low, high float64) (float64, error) {
+	if err := c.checkOperands(value, low, high); err != nil {
+		return 0, err
+	}
+	if low > high {
+		return 0, fmt.Errorf("%w: low %g is greater than high %g", ErrInvalidArgument, low, high)
+	}
+	if math.IsNaN(value) {
+		return value, nil
+	}
+	return c.round(math.Max(low, math.Min(value, high))), nil
+}
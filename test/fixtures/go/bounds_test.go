@@ -0,0 +1,79 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinMax(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Min(3, -1.5, 2)
+	require.NoError(t, err)
+	assert.Equal(t, -1.5, result)
+
+	result, err = calc.Max(3, -1.5, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, result)
+}
+
+func TestMinMaxSingleArgument(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Min(4.2)
+	require.NoError(t, err)
+	assert.Equal(t, 4.2, result)
+
+	result, err = calc.Max(4.2)
+	require.NoError(t, err)
+	assert.Equal(t, 4.2, result)
+}
+
+func TestMinMaxNoArguments(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Min()
+	assert.ErrorIs(t, err, ErrEmptyInput)
+	_, err = calc.Max()
+	assert.ErrorIs(t, err, ErrEmptyInput)
+}
+
+func TestMinMaxNaN(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Min(1, math.NaN(), 2)
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(result))
+
+	result, err = calc.Max(1, math.NaN())
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(result))
+}
+
+func TestClamp(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct{ value, expected float64 }{
+		{5, 5},
+		{-5, 0},
+		{15, 10},
+		{0, 0},
+		{10, 10},
+	}
+	for _, tt := range tests {
+		result, err := calc.Clamp(tt.value, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, result, "value %v", tt.value)
+	}
+}
+
+func TestClampLowGreaterThanHigh(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Clamp(5, 10, 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestClampNaN(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Clamp(math.NaN(), 0, 10)
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(result))
+}
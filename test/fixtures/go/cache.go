@@ -0,0 +1,97 @@
+package calculator
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// lruCache is a bounded, concurrency-safe least-recently-used cache of results
+type lruCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // front is most recently used
+	items    map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key   string
+	value float64
+}
+
+func newLRUCache(capacity int) *lruCache {
+	return &lruCache{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element, capacity),
+	}
+}
+
+func (lc *lruCache) get(key string) (float64, bool) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	elem, ok := lc.items[key]
+	if !ok {
+		return 0, false
+	}
+	lc.order.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).value, true
+}
+
+func (lc *lruCache) put(key string, value float64) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if elem, ok := lc.items[key]; ok {
+		elem.Value.(*cacheEntry).value = value
+		lc.order.MoveToFront(elem)
+		return
+	}
+	lc.items[key] = lc.order.PushFront(&cacheEntry{key: key, value: value})
+	if lc.order.Len() > lc.capacity {
+		oldest := lc.order.Back()
+		lc.order.Remove(oldest)
+		delete(lc.items, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (lc *lruCache) len() int {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	return lc.order.Len()
+}
+
+func (lc *lruCache) clear() {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	lc.order.Init()
+	lc.items = make(map[string]*list.Element, lc.capacity)
+}
+
+// memoize returns the cached result of op(args...) when caching is enabled,
+// otherwise calls compute. compute returns the unrounded value, which memoize
+// rounds with the same precision and rounding mode it puts in the key, so a
+// concurrent SetPrecision cannot store a result under the wrong key. Only
+// successful results are cached.
+func (c *Calculator) memoize(compute func() (float64, error), op string, args ...any) (float64, error) {
+	c.mu.RLock()
+	places, mode := c.precision, c.rounding
+	c.mu.RUnlock()
+	if c.cache == nil {
+		value, err := compute()
+		if err != nil {
+			return 0, err
+		}
+		return Round(value, places, mode), nil
+	}
+	key := fmt.Sprint(op, places, mode, args)
+	if value, ok := c.cache.get(key); ok {
+		return value, nil
+	}
+	value, err := compute()
+	if err != nil {
+		return 0, err
+	}
+	value = Round(value, places, mode)
+	c.cache.put(key, value)
+	return value, nil
+}
@@ -0,0 +1,131 @@
+package calculator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheHitReturnsIdenticalResult(t *testing.T) {
+	calc := NewCalculator(WithCache(8))
+	first, err := calc.Eval("2 + 3 * (4 - 1)")
+	require.NoError(t, err)
+	second, err := calc.Eval("2 + 3 * (4 - 1)")
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, calc.cache.len())
+
+	p1, err := calc.Power(1.1, 2)
+	require.NoError(t, err)
+	p2, err := calc.Power(1.1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, p1, p2)
+	assert.Equal(t, 2, calc.cache.len())
+}
+
+func TestCacheKeyIncludesPrecision(t *testing.T) {
+	calc := NewCalculator(WithCache(8))
+	result, err := calc.Eval("1 / 3")
+	require.NoError(t, err)
+	assert.Equal(t, 0.33, result)
+
+	require.NoError(t, calc.SetPrecision(4))
+	result, err = calc.Eval("1 / 3")
+	require.NoError(t, err)
+	assert.Equal(t, 0.3333, result)
+}
+
+func TestCacheHitHonoursCancelledContext(t *testing.T) {
+	calc := NewCalculator(WithCache(8))
+	result, err := calc.Eval("2*3")
+	require.NoError(t, err)
+	assert.Equal(t, 6.0, result)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = calc.EvalContext(ctx, "2*3")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestCacheRoundsWithKeyedPrecision(t *testing.T) {
+	calc := NewCalculator(WithCache(8))
+	// Changing precision mid-compute stands in for a concurrent SetPrecision
+	result, err := calc.memoize(func() (float64, error) {
+		require.NoError(t, calc.SetPrecision(4))
+		return 1.0 / 3, nil
+	}, "Third")
+	require.NoError(t, err)
+	assert.Equal(t, 0.33, result)
+
+	cached, ok := calc.cache.get(fmt.Sprint("Third", 2, RoundHalfUp, []any(nil)))
+	require.True(t, ok)
+	assert.Equal(t, 0.33, cached)
+}
+
+func TestCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newLRUCache(2)
+	cache.put("a", 1)
+	cache.put("b", 2)
+	_, _ = cache.get("a")
+	cache.put("c", 3)
+
+	assert.Equal(t, 2, cache.len())
+	_, ok := cache.get("b")
+	assert.False(t, ok)
+	value, ok := cache.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, value)
+}
+
+func TestCacheBoundedAtCapacity(t *testing.T) {
+	calc := NewCalculator(WithCache(3))
+	for _, x := range []float64{1, 4, 9, 16, 25} {
+		_, err := calc.Sqrt(x)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 3, calc.cache.len())
+}
+
+func TestCacheSkipsErrors(t *testing.T) {
+	calc := NewCalculator(WithCache(4))
+	_, err := calc.Eval("1 / 0")
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	assert.Equal(t, 0, calc.cache.len())
+}
+
+func TestRegisterFuncClearsCache(t *testing.T) {
+	calc := NewCalculator(WithCache(4))
+	calc.RegisterFunc("f", func(args ...float64) (float64, error) { return 1, nil })
+	result, err := calc.Eval("f()")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result)
+
+	calc.RegisterFunc("f", func(args ...float64) (float64, error) { return 2, nil })
+	result, err = calc.Eval("f()")
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, result)
+}
+
+func TestWithCacheDisabled(t *testing.T) {
+	assert.Nil(t, NewCalculator(WithCache(0)).cache)
+	assert.Nil(t, NewCalculator().cache)
+}
+
+const benchmarkExpr = "sqrt(2) * (3.5 + 4.25) / (1 - 0.25) + max(1, 2, 3) * 10% - abs(-42)"
+
+func BenchmarkEvalUncached(b *testing.B) {
+	calc := NewCalculator()
+	for i := 0; i < b.N; i++ {
+		_, _ = calc.Eval(benchmarkExpr)
+	}
+}
+
+func BenchmarkEvalCached(b *testing.B) {
+	calc := NewCalculator(WithCache(16))
+	for i := 0; i < b.N; i++ {
+		_, _ = calc.Eval(benchmarkExpr)
+	}
+}
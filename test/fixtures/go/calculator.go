@@ -1,36 +1,229 @@
 package calculator
 
-// Calculator provides basic math operations
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// MaxPrecision is the largest number of decimal places a float64 can reliably hold
+const MaxPrecision = 15
+
+// Calculator provides basic math operations.
+// A single Calculator is safe for concurrent use by multiple goroutines.
 type Calculator struct {
+	mu        sync.RWMutex
 	precision int
+	rounding  RoundingMode
+	angle     AngleMode
+	strict    bool
+	saturate  bool
+	maxDepth  int
+	memory    float64
+	funcs     map[string]Func
+	rates     RateTable
+	cache     *lruCache
+	history   []Operation
+}
+
+// NewCalculator creates a new calculator with default precision, adjusted by any options
+func NewCalculator(opts ...Option) *Calculator {
+	c := &Calculator{maxDepth: DefaultMaxDepth}
+	c.ApplyConfig(defaultConfig)
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Reset restores the default settings of NewCalculator, clearing the memory
+// register, history and result cache. Registered functions are kept, and a
+// cache enabled by WithCache stays enabled but empty.
+func (c *Calculator) Reset() {
+	c.ApplyConfig(defaultConfig)
+	c.mu.Lock()
+	c.memory = 0
+	c.history = nil
+	c.maxDepth = DefaultMaxDepth
+	c.mu.Unlock()
+	if c.cache != nil {
+		c.cache.clear()
+	}
+}
+
+// Precision returns the number of decimal places results are rounded to
+func (c *Calculator) Precision() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.precision
+}
+
+// SetPrecision changes the number of decimal places results are rounded to
+func (c *Calculator) SetPrecision(p int) error {
+	if p < 0 || p > MaxPrecision {
+		return ErrInvalidPrecision
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.precision = p
+	return nil
 }
 
-// NewCalculator creates a new calculator with default precision
-func NewCalculator() *Calculator {
-	return &Calculator{precision: 2}
+// RoundingMode returns how results are rounded to the calculator's precision
+func (c *Calculator) RoundingMode() RoundingMode {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.rounding
 }
 
 // Add returns the sum of two numbers
 func (c *Calculator) Add(a, b float64) float64 {
-	return a + b
+	result := c.round(a + b)
+	c.record("Add", result, a, b)
+	return result
 }
 
 // Subtract returns the difference of two numbers
 func (c *Calculator) Subtract(a, b float64) float64 {
-	return a - b
+	result := c.round(a - b)
+	c.record("Subtract", result, a, b)
+	return result
 }
 
 // Multiply returns the product of two numbers
 func (c *Calculator) Multiply(a, b float64) float64 {
-	return a * b
+	result := c.round(a * b)
+	c.record("Multiply", result, a, b)
+	return result
 }
 
 // Divide returns the quotient of two numbers
 func (c *Calculator) Divide(a, b float64) (float64, error) {
+	if err := c.checkOperands(a, b); err != nil {
+		return 0, err
+	}
+	if b == 0 {
+		return 0, &CalcError{Op: "Divide", A: a, B: b, Err: ErrDivisionByZero}
+	}
+	result := c.round(a / b)
+	c.record("Divide", result, a, b)
+	return result, nil
+}
+
+// Reciprocal returns 1/x
+func (c *Calculator) Reciprocal(x float64) (float64, error) {
+	return c.Divide(1, x)
+}
+
+// Modulo returns the floating-point remainder of a/b.
+// The result takes the sign of the dividend a, matching math.Mod.
+func (c *Calculator) Modulo(a, b float64) (float64, error) {
+	if err := c.checkOperands(a, b); err != nil {
+		return 0, err
+	}
 	if b == 0 {
 		return 0, ErrDivisionByZero
 	}
-	return a / b, nil
+	result := c.round(math.Mod(a, b))
+	c.record("Modulo", result, a, b)
+	return result, nil
+}
+
+// Power returns base raised to the given exponent
+func (c *Calculator) Power(base, exponent float64) (float64, error) {
+	if err := c.checkOperands(base, exponent); err != nil {
+		return 0, err
+	}
+	if base == 0 && exponent < 0 {
+		return 0, ErrZeroToNegativePower
+	}
+	result, _ := c.memoize(func() (float64, error) {
+		return math.Pow(base, exponent), nil
+	}, "Power", base, exponent)
+	c.record("Power", result, base, exponent)
+	return result, nil
+}
+
+// IntPower returns base raised to an integer exponent using exponentiation by
+// squaring, avoiding the error math.Pow introduces for small integer powers.
+// Negative exponents take the reciprocal; zero to a negative power is +Inf.
+func (c *Calculator) IntPower(base float64, exp int) float64 {
+	result := c.round(intPow(base, exp))
+	c.record("IntPower", result, base, float64(exp))
+	return result
+}
+
+func intPow(base float64, exp int) float64 {
+	// Negate via exp+1 so math.MinInt does not overflow
+	n := uint(exp)
+	if exp < 0 {
+		n = uint(-(exp + 1)) + 1
+	}
+	result := 1.0
+	for factor := base; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			result *= factor
+		}
+		factor *= factor
+	}
+	if exp < 0 {
+		return 1 / result
+	}
+	return result
+}
+
+// Sqrt returns the square root of x
+func (c *Calculator) Sqrt(x float64) (float64, error) {
+	if err := c.checkOperands(x); err != nil {
+		return 0, err
+	}
+	if x < 0 {
+		return 0, ErrNegativeSqrt
+	}
+	result, _ := c.memoize(func() (float64, error) {
+		return math.Sqrt(x), nil
+	}, "Sqrt", x)
+	c.record("Sqrt", result, x)
+	return result, nil
+}
+
+// NthRoot returns the nth root of x. Odd roots of negative numbers are
+// negative (NthRoot(-8, 3) is -2); even roots of negative numbers return
+// ErrNegativeEvenRoot. A negative n takes the reciprocal of the root.
+func (c *Calculator) NthRoot(x float64, n int) (float64, error) {
+	if err := c.checkOperands(x); err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, fmt.Errorf("%w: root degree must not be zero", ErrInvalidArgument)
+	}
+	if x < 0 && n%2 == 0 {
+		return 0, ErrNegativeEvenRoot
+	}
+	if x == 0 && n < 0 {
+		return 0, ErrZeroToNegativePower
+	}
+	root := math.Pow(math.Abs(x), 1/float64(n))
+	if x < 0 {
+		root = -root
+	}
+	result := c.round(root)
+	c.record("NthRoot", result, x, float64(n))
+	return result, nil
+}
+
+// Negate returns x with its sign flipped
+func (c *Calculator) Negate(x float64) float64 {
+	result := c.round(-x)
+	c.record("Negate", result, x)
+	return result
+}
+
+// Abs returns the absolute value of x. Abs(-0) is +0 and Abs(NaN) is NaN.
+func (c *Calculator) Abs(x float64) float64 {
+	result := c.round(math.Abs(x))
+	c.record("Abs", result, x)
+	return result
 }
 
 // ApplyDiscount applies a discount percentage to a price
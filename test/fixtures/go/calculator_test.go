@@ -1,18 +1,76 @@
 package calculator
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+func TestSetPrecision(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 2, calc.Precision())
+	require.NoError(t, calc.SetPrecision(4))
+	assert.Equal(t, 4, calc.Precision())
+	result, err := calc.Divide(1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0.3333, result)
+}
+
+func TestSetPrecisionZero(t *testing.T) {
+	calc := NewCalculator()
+	require.NoError(t, calc.SetPrecision(0))
+	assert.Equal(t, 0, calc.Precision())
+	assert.Equal(t, 3.0, calc.Add(1.2, 1.4))
+}
+
+func TestSetPrecisionNegative(t *testing.T) {
+	calc := NewCalculator()
+	err := calc.SetPrecision(-1)
+	assert.ErrorIs(t, err, ErrInvalidPrecision)
+	assert.Equal(t, 2, calc.Precision())
+}
+
+func TestSetPrecisionUpperBound(t *testing.T) {
+	calc := NewCalculator()
+	require.NoError(t, calc.SetPrecision(MaxPrecision))
+	assert.Equal(t, MaxPrecision, calc.Precision())
+	err := calc.SetPrecision(MaxPrecision + 1)
+	assert.ErrorIs(t, err, ErrInvalidPrecision)
+	assert.Equal(t, MaxPrecision, calc.Precision())
+}
+
+func TestReset(t *testing.T) {
+	calc := NewCalculator(WithPrecision(5), WithRoundingMode(RoundFloor), WithAngleMode(Degrees), WithStrictFinite(true), WithCache(4))
+	calc.MemoryAdd(42)
+	calc.Add(1, 2)
+	_, err := calc.Eval("1 + 1")
+	require.NoError(t, err)
+
+	calc.Reset()
+	assert.Equal(t, NewCalculator().Config(), calc.Config())
+	assert.Equal(t, 2, calc.Precision())
+	assert.Equal(t, RoundHalfUp, calc.RoundingMode())
+	assert.Equal(t, Radians, calc.AngleMode())
+	assert.False(t, calc.StrictFinite())
+	assert.Equal(t, 0.0, calc.MemoryRecall())
+	assert.Empty(t, calc.History())
+	assert.Equal(t, 0, calc.cache.len())
+}
+
 func TestAdd(t *testing.T) {
 	calc := NewCalculator()
 	result := calc.Add(2, 3)
 	assert.Equal(t, 5.0, result)
 }
 
+func TestAddRoundsToPrecision(t *testing.T) {
+	calc := NewCalculator()
+	result := calc.Add(0.1, 0.2)
+	assert.Equal(t, 0.30, result)
+}
+
 func TestSubtract(t *testing.T) {
 	calc := NewCalculator()
 	result := calc.Subtract(5, 3)
@@ -25,6 +83,19 @@ func TestMultiply(t *testing.T) {
 	assert.Equal(t, 12.0, result)
 }
 
+func TestMultiplyPrecisionZero(t *testing.T) {
+	calc := &Calculator{precision: 0}
+	assert.Equal(t, 2.0, calc.Multiply(1.5, 1.5))
+	assert.Equal(t, 5.0, calc.Multiply(2.4, 2))
+}
+
+func TestDivideRoundsToPrecision(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Divide(1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0.33, result)
+}
+
 func TestDivide(t *testing.T) {
 	calc := NewCalculator()
 	result, err := calc.Divide(10, 2)
@@ -38,6 +109,163 @@ func TestDivideByZero(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestReciprocal(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Reciprocal(4)
+	require.NoError(t, err)
+	assert.Equal(t, 0.25, result)
+
+	result, err = calc.Reciprocal(3)
+	require.NoError(t, err)
+	assert.Equal(t, 0.33, result)
+}
+
+func TestReciprocalOfZero(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Reciprocal(0)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestModulo(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Modulo(10, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result)
+}
+
+func TestModuloNegativeDividend(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Modulo(-10, 3)
+	require.NoError(t, err)
+	assert.Equal(t, -1.0, result)
+
+	result, err = calc.Modulo(10, -3)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result)
+}
+
+func TestModuloByZero(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Modulo(5, 0)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestPower(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Power(2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 8.0, result)
+}
+
+func TestPowerFractionalExponent(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Power(9, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, result)
+}
+
+func TestPowerNegativeExponent(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Power(2, -2)
+	require.NoError(t, err)
+	assert.Equal(t, 0.25, result)
+}
+
+func TestPowerZeroBaseNegativeExponent(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Power(0, -1)
+	assert.ErrorIs(t, err, ErrZeroToNegativePower)
+}
+
+func TestIntPower(t *testing.T) {
+	calc := NewCalculator(WithPrecision(MaxPrecision))
+	assert.Equal(t, 1.1*1.1, calc.IntPower(1.1, 2))
+	assert.Equal(t, 1024.0, calc.IntPower(2, 10))
+	assert.Equal(t, -27.0, calc.IntPower(-3, 3))
+	assert.Equal(t, 1.5*1.5*1.5*1.5*1.5, calc.IntPower(1.5, 5))
+}
+
+func TestIntPowerZeroExponent(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 1.0, calc.IntPower(7.5, 0))
+	assert.Equal(t, 1.0, calc.IntPower(0, 0))
+}
+
+func TestIntPowerNegativeExponent(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 0.25, calc.IntPower(2, -2))
+	assert.Equal(t, 0.13, calc.IntPower(2, -3))
+}
+
+func TestIntPowerZeroBase(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 0.0, calc.IntPower(0, 3))
+	assert.True(t, math.IsInf(calc.IntPower(0, -1), 1))
+}
+
+func TestSqrt(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Sqrt(16)
+	require.NoError(t, err)
+	assert.Equal(t, 4.0, result)
+}
+
+func TestSqrtNegative(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Sqrt(-4)
+	assert.ErrorIs(t, err, ErrNegativeSqrt)
+}
+
+func TestNthRoot(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		x        float64
+		n        int
+		expected float64
+	}{
+		{27, 3, 3},
+		{-8, 3, -2},
+		{16, 4, 2},
+		{32, 5, 2},
+		{-32, 5, -2},
+		{2, 1, 2},
+		{4, -2, 0.5},
+		{0, 3, 0},
+	}
+	for _, tt := range tests {
+		result, err := calc.NthRoot(tt.x, tt.n)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, result, "NthRoot(%v, %d)", tt.x, tt.n)
+	}
+}
+
+func TestNthRootErrors(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.NthRoot(-4, 2)
+	assert.ErrorIs(t, err, ErrNegativeEvenRoot)
+
+	_, err = calc.NthRoot(8, 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = calc.NthRoot(0, -2)
+	assert.ErrorIs(t, err, ErrZeroToNegativePower)
+}
+
+func TestNegate(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, -5.5, calc.Negate(5.5))
+	assert.Equal(t, 3.25, calc.Negate(calc.Negate(3.25)))
+}
+
+func TestAbs(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 5.5, calc.Abs(-5.5))
+	assert.Equal(t, 5.5, calc.Abs(5.5))
+	assert.Equal(t, 0.0, calc.Abs(0))
+	assert.False(t, math.Signbit(calc.Abs(math.Copysign(0, -1))))
+	assert.True(t, math.IsNaN(calc.Abs(math.NaN())))
+}
+
 func TestApplyDiscount(t *testing.T) {
 	result := ApplyDiscount(100, 10)
 	assert.Equal(t, 90.0, result)
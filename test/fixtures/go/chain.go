@@ -0,0 +1,106 @@
+package calculator
+
+// Chain builds up a calculation fluently, e.g. calc.Chain(10).Add(5).Multiply(2).Result().
+// Once an operation fails, later operations are no-ops and the error is kept.
+type Chain struct {
+	calc  *Calculator
+	value float64
+	steps []chainStep
+	err   error
+}
+
+// chainStep is an applied operation and the value it replaced
+type chainStep struct {
+	previous float64
+	op       Operation
+}
+
+// Chain starts a fluent calculation from the given value
+func (c *Calculator) Chain(start float64) *Chain {
+	return &Chain{calc: c, value: start}
+}
+
+// Add adds x to the running value
+func (ch *Chain) Add(x float64) *Chain {
+	if ch.err == nil {
+		ch.push("Add", ch.calc.Add(ch.value, x), x)
+	}
+	return ch
+}
+
+// Subtract subtracts x from the running value
+func (ch *Chain) Subtract(x float64) *Chain {
+	if ch.err == nil {
+		ch.push("Subtract", ch.calc.Subtract(ch.value, x), x)
+	}
+	return ch
+}
+
+// Multiply multiplies the running value by x
+func (ch *Chain) Multiply(x float64) *Chain {
+	if ch.err == nil {
+		ch.push("Multiply", ch.calc.Multiply(ch.value, x), x)
+	}
+	return ch
+}
+
+// Divide divides the running value by x
+func (ch *Chain) Divide(x float64) *Chain {
+	return ch.apply("Divide", ch.calc.Divide, x)
+}
+
+// Modulo replaces the running value with its remainder after dividing by x
+func (ch *Chain) Modulo(x float64) *Chain {
+	return ch.apply("Modulo", ch.calc.Modulo, x)
+}
+
+// Power raises the running value to the exponent x
+func (ch *Chain) Power(x float64) *Chain {
+	return ch.apply("Power", ch.calc.Power, x)
+}
+
+// Result returns the running value, ignoring any error
+func (ch *Chain) Result() float64 {
+	return ch.value
+}
+
+// ResultWithError returns the running value and the first error encountered
+func (ch *Chain) ResultWithError() (float64, error) {
+	return ch.value, ch.err
+}
+
+func (ch *Chain) apply(name string, op func(a, b float64) (float64, error), x float64) *Chain {
+	if ch.err != nil {
+		return ch
+	}
+	value, err := op(ch.value, x)
+	if err != nil {
+		ch.err = err
+		return ch
+	}
+	ch.push(name, value, x)
+	return ch
+}
+
+// Undo reverts the most recent operation and removes it from the
+// calculator's History. If the chain is in an error state, Undo clears the
+// error and leaves the last good value in place.
+func (ch *Chain) Undo() *Chain {
+	if ch.err != nil {
+		ch.err = nil
+		return ch
+	}
+	if n := len(ch.steps); n > 0 {
+		step := ch.steps[n-1]
+		ch.value = step.previous
+		ch.steps = ch.steps[:n-1]
+		ch.calc.forget(step.op)
+	}
+	return ch
+}
+
+func (ch *Chain) push(name string, value, x float64) {
+	op := Operation{Name: name, Operands: []float64{ch.value, x}, Result: value}
+	ch.steps = append(ch.steps, chainStep{previous: ch.value, op: op})
+	ch.value = value
+}
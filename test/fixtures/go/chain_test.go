@@ -0,0 +1,28 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Chain(10).Add(5).Multiply(2).Subtract(3).ResultWithError()
+	require.NoError(t, err)
+	assert.Equal(t, 27.0, result)
+}
+
+func TestChainResult(t *testing.T) {
+	calc := NewCalculator()
+	result := calc.Chain(2).Power(3).Divide(4).Modulo(3).Result()
+	assert.Equal(t, 2.0, result)
+}
+
+func TestChainDivideByZeroShortCircuits(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Chain(10).Add(5).Divide(0).Multiply(2).Add(1).ResultWithError()
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	assert.Equal(t, 15.0, result)
+}
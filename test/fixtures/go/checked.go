@@ -0,0 +1,97 @@
+package calculator
+
+import "math"
+
+// AddChecked returns the sum of two numbers, or an error if it is not finite
+func (c *Calculator) AddChecked(a, b float64) (float64, error) {
+	return c.checked("Add", a+b, a, b)
+}
+
+// SubtractChecked returns the difference of two numbers, or an error if it is not finite
+func (c *Calculator) SubtractChecked(a, b float64) (float64, error) {
+	return c.checked("Subtract", a-b, a, b)
+}
+
+// MultiplyChecked returns the product of two numbers, or an error if it is not finite
+func (c *Calculator) MultiplyChecked(a, b float64) (float64, error) {
+	return c.checked("Multiply", a*b, a, b)
+}
+
+// IntPowerChecked is like IntPower but returns ErrZeroToNegativePower for
+// zero to a negative power and, unless the calculator saturates on
+// overflow, ErrOverflow when a finite base overflows to an infinity
+func (c *Calculator) IntPowerChecked(base float64, exp int) (float64, error) {
+	if err := c.checkOperands(base); err != nil {
+		return 0, err
+	}
+	if base == 0 && exp < 0 {
+		return 0, ErrZeroToNegativePower
+	}
+	result := intPow(base, exp)
+	if math.IsInf(result, 0) && isFinite(base) && !c.saturatesOnOverflow() {
+		return 0, ErrOverflow
+	}
+	result = c.round(result)
+	c.record("IntPower", result, base, float64(exp))
+	return result, nil
+}
+
+// saturatesOnOverflow reports the policy set by WithSaturateOnOverflow
+func (c *Calculator) saturatesOnOverflow() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.saturate
+}
+
+// checked validates an unrounded result and its operands, then rounds and
+// records it. Non-finite operands yield ErrNotFinite; a finite computation
+// that produces an infinity yields ErrOverflow.
+func (c *Calculator) checked(name string, result float64, operands ...float64) (float64, error) {
+	for _, x := range operands {
+		if !isFinite(x) {
+			return 0, ErrNotFinite
+		}
+	}
+	if err := checkFinite(result); err != nil {
+		return 0, err
+	}
+	result = c.round(result)
+	c.record(name, result, operands...)
+	return result, nil
+}
+
+// StrictFinite reports whether non-finite operands are rejected with ErrNotFinite
+func (c *Calculator) StrictFinite() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.strict
+}
+
+// checkOperands returns ErrNotFinite for a NaN or infinite operand when the
+// calculator is in strict-finite mode
+func (c *Calculator) checkOperands(operands ...float64) error {
+	if !c.StrictFinite() {
+		return nil
+	}
+	for _, x := range operands {
+		if !isFinite(x) {
+			return ErrNotFinite
+		}
+	}
+	return nil
+}
+
+// checkFinite reports ErrOverflow for an infinite value and ErrNotFinite for NaN
+func checkFinite(x float64) error {
+	if math.IsInf(x, 0) {
+		return ErrOverflow
+	}
+	if math.IsNaN(x) {
+		return ErrNotFinite
+	}
+	return nil
+}
+
+func isFinite(x float64) bool {
+	return !math.IsNaN(x) && !math.IsInf(x, 0)
+}
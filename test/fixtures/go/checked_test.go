@@ -0,0 +1,139 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiplyCheckedOverflow(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.MultiplyChecked(math.MaxFloat64, 2)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestAddCheckedOverflow(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.AddChecked(math.MaxFloat64, math.MaxFloat64)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = calc.SubtractChecked(-math.MaxFloat64, math.MaxFloat64)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestCheckedNaNInput(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.AddChecked(math.NaN(), 1)
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestMultiplyCheckedZeroTimesInf(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.MultiplyChecked(0, math.Inf(1))
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestCheckedSuccess(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.MultiplyChecked(1.5, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 4.5, result)
+	assert.Len(t, calc.History(), 1)
+}
+
+func TestNonStrictPropagatesNonFinite(t *testing.T) {
+	calc := NewCalculator()
+	assert.False(t, calc.StrictFinite())
+	assert.True(t, math.IsNaN(calc.Add(math.NaN(), 1)))
+	assert.True(t, math.IsInf(calc.Add(math.Inf(1), 1), 1))
+
+	result, err := calc.Divide(math.Inf(1), 2)
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(result, 1))
+
+	result, err = calc.Sqrt(math.NaN())
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(result))
+
+	result, err = calc.Average([]float64{1, math.Inf(1)})
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(result, 1))
+}
+
+func TestStrictFiniteRejectsNonFinite(t *testing.T) {
+	calc := NewCalculator(WithStrictFinite(true))
+	assert.True(t, calc.StrictFinite())
+
+	for _, x := range []float64{math.NaN(), math.Inf(1)} {
+		_, err := calc.Divide(x, 2)
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Modulo(5, x)
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Power(x, 2)
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Sqrt(x)
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Ln(x)
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Average([]float64{1, x})
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.WeightedAverage([]float64{1, 2}, []float64{1, x})
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Median([]float64{x, 1})
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Variance([]float64{1, x})
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.SampleStdDev([]float64{1, x})
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Min(1, x)
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Max(x, 1)
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.Clamp(x, 0, 1)
+		assert.ErrorIs(t, err, ErrNotFinite)
+		_, err = calc.RoundToNearest(x, 0.05)
+		assert.ErrorIs(t, err, ErrNotFinite)
+	}
+}
+
+// Strict mode cannot reach Add without an error result, so both modes agree
+func TestAddIgnoresStrictFinite(t *testing.T) {
+	for _, strict := range []bool{false, true} {
+		calc := NewCalculator(WithStrictFinite(strict))
+		assert.True(t, math.IsNaN(calc.Add(math.NaN(), 1)), "strict=%v", strict)
+		assert.True(t, math.IsInf(calc.Add(math.Inf(1), 1), 1), "strict=%v", strict)
+
+		for _, x := range []float64{math.NaN(), math.Inf(1)} {
+			_, err := calc.AddChecked(x, 1)
+			assert.ErrorIs(t, err, ErrNotFinite, "strict=%v", strict)
+		}
+	}
+}
+
+func TestStrictFiniteAllowsFinite(t *testing.T) {
+	calc := NewCalculator(WithStrictFinite(true))
+	result, err := calc.Divide(10, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, result)
+}
+
+func TestIntPowerCheckedOverflowPolicy(t *testing.T) {
+	_, err := NewCalculator().IntPowerChecked(10, 400)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	result, err := NewCalculator(WithSaturateOnOverflow(true)).IntPowerChecked(10, 400)
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(result, 1))
+}
+
+func TestIntPowerChecked(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.IntPowerChecked(2, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1024.0, result)
+
+	_, err = calc.IntPowerChecked(0, -1)
+	assert.ErrorIs(t, err, ErrZeroToNegativePower)
+}
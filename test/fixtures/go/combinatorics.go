@@ -0,0 +1,81 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MaxExactFactorial is the largest n whose factorial float64 represents exactly
+const MaxExactFactorial = 22
+
+// Factorial returns n!, or ErrOverflow when n exceeds MaxExactFactorial
+func Factorial(n int) (float64, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("%w: factorial of negative number %d", ErrInvalidArgument, n)
+	}
+	if n > MaxExactFactorial {
+		return 0, ErrOverflow
+	}
+	result := 1.0
+	for i := 2; i <= n; i++ {
+		result *= float64(i)
+	}
+	return result, nil
+}
+
+// Factorial is like the package-level Factorial but follows the
+// calculator's overflow policy, returning +Inf instead of ErrOverflow when
+// it saturates
+func (c *Calculator) Factorial(n int) (float64, error) {
+	result, err := Factorial(n)
+	if errors.Is(err, ErrOverflow) && c.saturatesOnOverflow() {
+		result, err = math.Inf(1), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	c.record("Factorial", result, float64(n))
+	return result, nil
+}
+
+// Combinations returns the number of ways to choose k items from n, ignoring order
+func Combinations(n, k int) (float64, error) {
+	if err := validateChoose(n, k); err != nil {
+		return 0, err
+	}
+	if k > n-k {
+		k = n - k
+	}
+	// Multiply and divide alternately so intermediate values stay small and exact
+	result := 1.0
+	for i := 1; i <= k; i++ {
+		result = result * float64(n-k+i) / float64(i)
+		if math.IsInf(result, 0) {
+			return 0, ErrOverflow
+		}
+	}
+	return math.Round(result), nil
+}
+
+// Permutations returns the number of ordered arrangements of k items from n
+func Permutations(n, k int) (float64, error) {
+	if err := validateChoose(n, k); err != nil {
+		return 0, err
+	}
+	result := 1.0
+	for i := 0; i < k; i++ {
+		result *= float64(n - i)
+		if math.IsInf(result, 0) {
+			return 0, ErrOverflow
+		}
+	}
+	return result, nil
+}
+
+func validateChoose(n, k int) error {
+	if k < 0 || k > n {
+		return fmt.Errorf("%w: need 0 <= k <= n, got n=%d k=%d", ErrInvalidArgument, n, k)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package calculator
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFactorial(t *testing.T) {
+	result, err := Factorial(5)
+	require.NoError(t, err)
+	assert.Equal(t, 120.0, result)
+
+	result, err = Factorial(0)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result)
+}
+
+func TestFactorialLargestExact(t *testing.T) {
+	result, err := Factorial(MaxExactFactorial)
+	require.NoError(t, err)
+
+	exact := new(big.Int).MulRange(1, MaxExactFactorial)
+	got, accuracy := big.NewFloat(result).Int(nil)
+	assert.Equal(t, big.Exact, accuracy)
+	assert.Equal(t, exact, got)
+}
+
+func TestFactorialErrors(t *testing.T) {
+	_, err := Factorial(-1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = Factorial(MaxExactFactorial + 1)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestCombinations(t *testing.T) {
+	result, err := Combinations(5, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, result)
+
+	result, err = Combinations(5, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result)
+
+	result, err = Combinations(40, 20)
+	require.NoError(t, err)
+	assert.Equal(t, 137846528820.0, result)
+}
+
+func TestPermutations(t *testing.T) {
+	result, err := Permutations(5, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 20.0, result)
+
+	result, err = Permutations(5, 5)
+	require.NoError(t, err)
+	assert.Equal(t, 120.0, result)
+}
+
+func TestCombinatoricsInvalidK(t *testing.T) {
+	_, err := Combinations(5, 6)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = Combinations(5, -1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = Permutations(3, 4)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestCombinatoricsOverflowStopsEarly(t *testing.T) {
+	// Looping to k would take minutes; overflow must end the loop at once
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := Permutations(1<<40, 1<<40)
+		assert.ErrorIs(t, err, ErrOverflow)
+		_, err = Combinations(1<<41, 1<<40)
+		assert.ErrorIs(t, err, ErrOverflow)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("overflowing Permutations/Combinations did not return early")
+	}
+}
+
+func TestCalculatorFactorialOverflowPolicy(t *testing.T) {
+	_, err := NewCalculator().Factorial(MaxExactFactorial + 1)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	result, err := NewCalculator(WithSaturateOnOverflow(true)).Factorial(MaxExactFactorial + 1)
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(result, 1))
+}
+
+func TestCalculatorFactorial(t *testing.T) {
+	calc := NewCalculator(WithSaturateOnOverflow(true))
+	result, err := calc.Factorial(5)
+	require.NoError(t, err)
+	assert.Equal(t, 120.0, result)
+
+	_, err = calc.Factorial(-1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
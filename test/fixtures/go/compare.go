@@ -0,0 +1,55 @@
+package calculator
+
+import "math"
+
+// AlmostEqual reports whether a and b differ by at most epsilon
+func AlmostEqual(a, b, epsilon float64) bool {
+	if a == b {
+		return true
+	}
+	return math.Abs(a-b) <= epsilon
+}
+
+// Equal reports whether a and b are equal at the calculator's precision,
+// i.e. they differ by at most half a unit in the last decimal place
+func (c *Calculator) Equal(a, b float64) bool {
+	return AlmostEqual(a, b, c.tolerance())
+}
+
+func (c *Calculator) tolerance() float64 {
+	return 0.5 * math.Pow10(-c.Precision())
+}
+
+// Sign returns -1, 0 or 1 according to the sign of x. Negative zero and NaN
+// both yield 0.
+func Sign(x float64) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Compare returns -1 if a < b, 1 if a > b and 0 if they are equal at the
+// calculator's precision, so it agrees with Equal. As with cmp.Compare, NaN
+// orders before every other value and equal to itself.
+func (c *Calculator) Compare(a, b float64) int {
+	aNaN, bNaN := math.IsNaN(a), math.IsNaN(b)
+	switch {
+	case aNaN && bNaN:
+		return 0
+	case aNaN:
+		return -1
+	case bNaN:
+		return 1
+	case c.Equal(a, b):
+		return 0
+	case a < b:
+		return -1
+	default:
+		return 1
+	}
+}
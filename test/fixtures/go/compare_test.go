@@ -0,0 +1,55 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	calc := NewCalculator()
+	assert.True(t, calc.Equal(0.1+0.2, 0.3))
+	assert.False(t, calc.Equal(0.1, 0.2))
+	assert.True(t, calc.Equal(1.004, 1.0))
+	assert.False(t, calc.Equal(1.006, 1.0))
+}
+
+func TestEqualFollowsPrecision(t *testing.T) {
+	calc := NewCalculator(WithPrecision(0))
+	assert.True(t, calc.Equal(1.4, 1))
+	assert.False(t, calc.Equal(1.6, 1))
+}
+
+func TestAlmostEqual(t *testing.T) {
+	assert.True(t, AlmostEqual(1.0, 1.0+1e-10, 1e-9))
+	assert.False(t, AlmostEqual(1.0, 1.1, 1e-9))
+	assert.True(t, AlmostEqual(math.Inf(1), math.Inf(1), 1e-9))
+	assert.False(t, AlmostEqual(math.NaN(), math.NaN(), 1e-9))
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, 1, Sign(3.5))
+	assert.Equal(t, 1, Sign(math.Inf(1)))
+	assert.Equal(t, -1, Sign(-0.001))
+	assert.Equal(t, -1, Sign(math.Inf(-1)))
+	assert.Equal(t, 0, Sign(0))
+	assert.Equal(t, 0, Sign(math.Copysign(0, -1)))
+	assert.Equal(t, 0, Sign(math.NaN()))
+}
+
+func TestCompare(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, -1, calc.Compare(1, 2))
+	assert.Equal(t, 1, calc.Compare(2, 1))
+	assert.Equal(t, 0, calc.Compare(0.1+0.2, 0.3))
+	assert.Equal(t, 0, calc.Compare(1.004, 1.0))
+	assert.Equal(t, 1, calc.Compare(1.006, 1.0))
+}
+
+func TestCompareNaN(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, -1, calc.Compare(math.NaN(), 1))
+	assert.Equal(t, 1, calc.Compare(1, math.NaN()))
+	assert.Equal(t, 0, calc.Compare(math.NaN(), math.NaN()))
+}
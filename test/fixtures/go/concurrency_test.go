@@ -0,0 +1,35 @@
+package calculator
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConcurrentUse is meant to be run with `go test -race`
+func TestConcurrentUse(t *testing.T) {
+	calc := NewCalculator()
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				_ = calc.SetPrecision((i + j) % (MaxPrecision + 1))
+				calc.Add(1.5, 2.25)
+				calc.Multiply(3, 0.1)
+				_, _ = calc.Divide(1, 3)
+				_ = calc.Precision()
+				_ = calc.History()
+				if j%25 == 0 {
+					calc.ClearHistory()
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, len(calc.History()), MaxHistory)
+}
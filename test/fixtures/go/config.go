@@ -0,0 +1,50 @@
+package calculator
+
+import "fmt"
+
+// Config is a snapshot of a Calculator's settings
+type Config struct {
+	Precision          int          `json:"precision"`
+	RoundingMode       RoundingMode `json:"roundingMode"`
+	AngleMode          AngleMode    `json:"angleMode"`
+	StrictFinite       bool         `json:"strictFinite"`
+	SaturateOnOverflow bool         `json:"saturateOnOverflow"`
+}
+
+// defaultConfig holds the settings of NewCalculator and Reset
+var defaultConfig = Config{Precision: 2, RoundingMode: RoundHalfUp, AngleMode: Radians}
+
+// Config returns a snapshot of the calculator's settings
+func (c *Calculator) Config() Config {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Config{
+		Precision:          c.precision,
+		RoundingMode:       c.rounding,
+		AngleMode:          c.angle,
+		StrictFinite:       c.strict,
+		SaturateOnOverflow: c.saturate,
+	}
+}
+
+// ApplyConfig replaces all of the calculator's settings at once. A precision
+// outside [0, MaxPrecision] is ignored and the current precision kept.
+func (c *Calculator) ApplyConfig(cfg Config) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cfg.Precision >= 0 && cfg.Precision <= MaxPrecision {
+		c.precision = cfg.Precision
+	}
+	c.rounding = cfg.RoundingMode
+	c.angle = cfg.AngleMode
+	c.strict = cfg.StrictFinite
+	c.saturate = cfg.SaturateOnOverflow
+}
+
+// String describes the calculator's settings for logging, e.g.
+// "Calculator{precision:2, rounding:half-up, angle:radians, strict:false, saturate:false}"
+func (c *Calculator) String() string {
+	cfg := c.Config()
+	return fmt.Sprintf("Calculator{precision:%d, rounding:%s, angle:%s, strict:%t, saturate:%t}",
+		cfg.Precision, cfg.RoundingMode, cfg.AngleMode, cfg.StrictFinite, cfg.SaturateOnOverflow)
+}
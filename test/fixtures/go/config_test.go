@@ -0,0 +1,55 @@
+package calculator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigSnapshotAndRestore(t *testing.T) {
+	calc := NewCalculator()
+	require.NoError(t, calc.SetPrecision(4))
+	calc.SetAngleMode(Degrees)
+	snapshot := calc.Config()
+
+	calc.ApplyConfig(Config{Precision: 0, RoundingMode: RoundCeil, AngleMode: Radians, StrictFinite: true})
+	assert.NotEqual(t, snapshot, calc.Config())
+
+	calc.ApplyConfig(snapshot)
+	assert.Equal(t, snapshot, calc.Config())
+	assert.Equal(t, 4, calc.Precision())
+	assert.Equal(t, RoundHalfUp, calc.RoundingMode())
+	assert.Equal(t, Degrees, calc.AngleMode())
+	assert.False(t, calc.StrictFinite())
+}
+
+func TestApplyConfigIgnoresInvalidPrecision(t *testing.T) {
+	calc := NewCalculator()
+	calc.ApplyConfig(Config{Precision: -1, RoundingMode: RoundFloor})
+	assert.Equal(t, 2, calc.Precision())
+	assert.Equal(t, RoundFloor, calc.RoundingMode())
+}
+
+func TestConfigJSONRoundTrip(t *testing.T) {
+	cfg := NewCalculator(WithPrecision(3), WithRoundingMode(RoundHalfEven), WithStrictFinite(true)).Config()
+	data, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"precision":3,"roundingMode":1,"angleMode":0,"strictFinite":true,"saturateOnOverflow":false}`, string(data))
+
+	var decoded Config
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, decoded == cfg)
+}
+
+func TestCalculatorString(t *testing.T) {
+	assert.Equal(t, "Calculator{precision:2, rounding:half-up, angle:radians, strict:false, saturate:false}", NewCalculator().String())
+
+	calc := NewCalculator(WithPrecision(4), WithRoundingMode(RoundHalfEven), WithAngleMode(Degrees), WithStrictFinite(true))
+	s := calc.String()
+	assert.Contains(t, s, "precision:4")
+	assert.Contains(t, s, "rounding:half-even")
+	assert.Contains(t, s, "angle:degrees")
+	assert.Contains(t, s, "strict:true")
+}
@@ -0,0 +1,23 @@
+package calculator
+
+import "math"
+
+// DegreesToRadians converts an angle from degrees to radians
+func DegreesToRadians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+// RadiansToDegrees converts an angle from radians to degrees
+func RadiansToDegrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
+
+// CelsiusToFahrenheit converts a temperature from Celsius to Fahrenheit
+func CelsiusToFahrenheit(c float64) float64 {
+	return c*9/5 + 32
+}
+
+// FahrenheitToCelsius converts a temperature from Fahrenheit to Celsius
+func FahrenheitToCelsius(f float64) float64 {
+	return (f - 32) * 5 / 9
+}
@@ -0,0 +1,29 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDegreesToRadians(t *testing.T) {
+	assert.InDelta(t, math.Pi, DegreesToRadians(180), 1e-12)
+	assert.InDelta(t, 90.0, RadiansToDegrees(math.Pi/2), 1e-12)
+}
+
+func TestAngleConversionRoundTrip(t *testing.T) {
+	for _, x := range []float64{0, 1, -2.5, math.Pi, 1234.5678} {
+		assert.InDelta(t, x, DegreesToRadians(RadiansToDegrees(x)), 1e-9)
+		assert.InDelta(t, x, RadiansToDegrees(DegreesToRadians(x)), 1e-9)
+	}
+}
+
+func TestTemperatureConversion(t *testing.T) {
+	assert.Equal(t, 212.0, CelsiusToFahrenheit(100))
+	assert.Equal(t, 32.0, CelsiusToFahrenheit(0))
+	assert.Equal(t, -40.0, FahrenheitToCelsius(-40))
+	for _, x := range []float64{-40, 0, 37, 100.5} {
+		assert.InDelta(t, x, FahrenheitToCelsius(CelsiusToFahrenheit(x)), 1e-9)
+	}
+}
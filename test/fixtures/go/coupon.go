@@ -0,0 +1,48 @@
+package calculator
+
+import "fmt"
+
+// CouponType selects how a Coupon's Value is applied
+type CouponType int
+
+const (
+	// CouponPercent takes Value percent off the price
+	CouponPercent CouponType = iota
+	// CouponFixedAmount takes Value off the price
+	CouponFixedAmount
+)
+
+// Coupon is a named discount
+type Coupon struct {
+	Code  string
+	Type  CouponType
+	Value float64
+}
+
+// ApplyCoupon applies a coupon to a price. The result never drops below zero,
+// so a fixed-amount coupon larger than the price yields 0.
+func ApplyCoupon(price float64, coupon Coupon) (float64, error) {
+	if price < 0 {
+		return 0, ErrNegativePrice
+	}
+	if coupon.Value < 0 {
+		return 0, fmt.Errorf("%w: coupon %q has negative value %g", ErrInvalidArgument, coupon.Code, coupon.Value)
+	}
+
+	var result float64
+	switch coupon.Type {
+	case CouponPercent:
+		if !validDiscount(coupon.Value) {
+			return 0, fmt.Errorf("coupon %q: %w", coupon.Code, ErrInvalidDiscount)
+		}
+		result = ApplyDiscount(price, coupon.Value)
+	case CouponFixedAmount:
+		result = price - coupon.Value
+	default:
+		return 0, fmt.Errorf("%w: coupon %q has unknown type %d", ErrInvalidArgument, coupon.Code, coupon.Type)
+	}
+	if result < 0 {
+		return 0, nil
+	}
+	return result, nil
+}
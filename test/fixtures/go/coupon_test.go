@@ -0,0 +1,40 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyCouponPercent(t *testing.T) {
+	result, err := ApplyCoupon(80, Coupon{Code: "SAVE25", Type: CouponPercent, Value: 25})
+	require.NoError(t, err)
+	assert.Equal(t, 60.0, result)
+}
+
+func TestApplyCouponFixedAmount(t *testing.T) {
+	result, err := ApplyCoupon(80, Coupon{Code: "TENOFF", Type: CouponFixedAmount, Value: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 70.0, result)
+}
+
+func TestApplyCouponFixedAmountClampsToZero(t *testing.T) {
+	result, err := ApplyCoupon(30, Coupon{Code: "FIFTY", Type: CouponFixedAmount, Value: 50})
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, result)
+}
+
+func TestApplyCouponValidation(t *testing.T) {
+	_, err := ApplyCoupon(80, Coupon{Code: "BAD", Type: CouponFixedAmount, Value: -5})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = ApplyCoupon(80, Coupon{Code: "TOOMUCH", Type: CouponPercent, Value: 150})
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+
+	_, err = ApplyCoupon(80, Coupon{Code: "ODD", Type: CouponType(99), Value: 5})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = ApplyCoupon(-1, Coupon{Code: "SAVE25", Type: CouponPercent, Value: 25})
+	assert.ErrorIs(t, err, ErrNegativePrice)
+}
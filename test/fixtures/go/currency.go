@@ -0,0 +1,51 @@
+package calculator
+
+import "fmt"
+
+// RateTable maps currency codes to exchange rates against a common base
+// currency: the number of units of that currency one unit of base buys. The
+// base itself, if listed, has rate 1.
+type RateTable map[string]float64
+
+func (rt RateTable) clone() RateTable {
+	if rt == nil {
+		return nil
+	}
+	cp := make(RateTable, len(rt))
+	for code, rate := range rt {
+		cp[code] = rate
+	}
+	return cp
+}
+
+// SetRateTable replaces the exchange rates Convert uses. The table is copied.
+func (c *Calculator) SetRateTable(rates RateTable) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rates = rates.clone()
+}
+
+// Convert converts amount from one currency to another through the base
+// currency of the calculator's rate table. A code missing from the table
+// returns ErrUnknownCurrency, and a rate that is not positive is an error.
+func (c *Calculator) Convert(amount float64, from, to string) (float64, error) {
+	if err := c.checkOperands(amount); err != nil {
+		return 0, err
+	}
+	c.mu.RLock()
+	fromRate, fromOK := c.rates[from]
+	toRate, toOK := c.rates[to]
+	c.mu.RUnlock()
+	if !fromOK {
+		return 0, fmt.Errorf("%w %q", ErrUnknownCurrency, from)
+	}
+	if !toOK {
+		return 0, fmt.Errorf("%w %q", ErrUnknownCurrency, to)
+	}
+	if !(fromRate > 0) || !(toRate > 0) {
+		return 0, fmt.Errorf("%w: exchange rate must be positive", ErrInvalidArgument)
+	}
+	result := c.round(amount / fromRate * toRate)
+	c.record("Convert", result, amount)
+	return result, nil
+}
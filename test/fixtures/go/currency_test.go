@@ -0,0 +1,60 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRates = RateTable{"USD": 1, "EUR": 0.8, "JPY": 150}
+
+func TestConvert(t *testing.T) {
+	calc := NewCalculator(WithRateTable(testRates))
+	eur, err := calc.Convert(100, "USD", "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, 80.0, eur)
+
+	usd, err := calc.Convert(eur, "EUR", "USD")
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, usd)
+
+	jpy, err := calc.Convert(10, "EUR", "JPY")
+	require.NoError(t, err)
+	assert.Equal(t, 1875.0, jpy)
+
+	same, err := calc.Convert(12.345, "EUR", "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, 12.35, same)
+}
+
+func TestConvertUnknownCurrency(t *testing.T) {
+	calc := NewCalculator(WithRateTable(testRates))
+	_, err := calc.Convert(100, "USD", "GBP")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+	assert.Contains(t, err.Error(), `"GBP"`)
+	_, err = calc.Convert(100, "XXX", "USD")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+
+	_, err = NewCalculator().Convert(100, "USD", "EUR")
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+}
+
+func TestConvertZeroRate(t *testing.T) {
+	calc := NewCalculator(WithRateTable(RateTable{"USD": 1, "BAD": 0}))
+	_, err := calc.Convert(100, "BAD", "USD")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = calc.Convert(100, "USD", "BAD")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestRateTableIsCopied(t *testing.T) {
+	rates := RateTable{"USD": 1, "EUR": 0.8}
+	calc := NewCalculator()
+	calc.SetRateTable(rates)
+	rates["EUR"] = 2
+
+	eur, err := calc.Convert(100, "USD", "EUR")
+	require.NoError(t, err)
+	assert.Equal(t, 80.0, eur)
+}
@@ -0,0 +1,185 @@
+package calculator
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// AddStrings adds two decimal strings exactly and returns the sum formatted
+// to the calculator's precision, so AddStrings("0.1", "0.2") is "0.30"
+func (c *Calculator) AddStrings(a, b string) (string, error) {
+	return c.decimalOp(a, b, func(z, x, y *big.Rat) error {
+		z.Add(x, y)
+		return nil
+	})
+}
+
+// SubtractStrings subtracts decimal string b from a exactly
+func (c *Calculator) SubtractStrings(a, b string) (string, error) {
+	return c.decimalOp(a, b, func(z, x, y *big.Rat) error {
+		z.Sub(x, y)
+		return nil
+	})
+}
+
+// MultiplyStrings multiplies two decimal strings exactly
+func (c *Calculator) MultiplyStrings(a, b string) (string, error) {
+	return c.decimalOp(a, b, func(z, x, y *big.Rat) error {
+		z.Mul(x, y)
+		return nil
+	})
+}
+
+// DivideStrings divides decimal string a by b exactly before rounding,
+// returning ErrDivisionByZero when b is zero
+func (c *Calculator) DivideStrings(a, b string) (string, error) {
+	return c.decimalOp(a, b, func(z, x, y *big.Rat) error {
+		if y.Sign() == 0 {
+			return ErrDivisionByZero
+		}
+		z.Quo(x, y)
+		return nil
+	})
+}
+
+// DivideDetailed is like Divide but also reports whether the quotient
+// terminates within the calculator's precision, i.e. whether rounding lost
+// nothing. Operands are taken at their shortest decimal value, so 0.3/0.1 is
+// exactly 3 despite binary floating-point noise.
+func (c *Calculator) DivideDetailed(a, b float64) (result float64, exact bool, err error) {
+	result, err = c.Divide(a, b)
+	if err != nil {
+		return 0, false, err
+	}
+	return result, terminatesWithin(a, b, c.Precision()), nil
+}
+
+// terminatesWithin reports whether the decimal quotient a/b has at most
+// places digits after the decimal point
+func terminatesWithin(a, b float64, places int) bool {
+	x, errA := parseDecimal(strconv.FormatFloat(a, 'g', -1, 64))
+	y, errB := parseDecimal(strconv.FormatFloat(b, 'g', -1, 64))
+	if errA != nil || errB != nil {
+		return false
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	q := new(big.Rat).Quo(x, y)
+	return q.Mul(q, new(big.Rat).SetInt(scale)).IsInt()
+}
+
+// decimalOp parses both operands, applies op exactly and formats the result
+// using the calculator's precision and rounding mode
+func (c *Calculator) decimalOp(a, b string, op func(z, x, y *big.Rat) error) (string, error) {
+	x, err := parseDecimal(a)
+	if err != nil {
+		return "", err
+	}
+	y, err := parseDecimal(b)
+	if err != nil {
+		return "", err
+	}
+	z := new(big.Rat)
+	if err := op(z, x, y); err != nil {
+		return "", err
+	}
+	cfg := c.Config()
+	return formatDecimal(z, cfg.Precision, cfg.RoundingMode), nil
+}
+
+// parseDecimal parses a decimal string such as "-12.50" or "1e-3" exactly.
+// Forms big.Rat would otherwise accept, like "1/3" or "0x10", are rejected.
+func parseDecimal(s string) (*big.Rat, error) {
+	trimmed := strings.TrimSpace(s)
+	if !isDecimalLiteral(trimmed) {
+		return nil, fmt.Errorf("%w %q", ErrInvalidNumber, s)
+	}
+	r, ok := new(big.Rat).SetString(trimmed)
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrInvalidNumber, s)
+	}
+	return r, nil
+}
+
+// isDecimalLiteral reports whether s is an optional sign, digits with an
+// optional fraction (at least one digit overall) and an optional exponent
+func isDecimalLiteral(s string) bool {
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	digits := 0
+	for ; i < len(s) && isDigit(s[i]); i++ {
+		digits++
+	}
+	if i < len(s) && s[i] == '.' {
+		for i++; i < len(s) && isDigit(s[i]); i++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return false
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		start := i
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	return i == len(s)
+}
+
+// formatDecimal renders r with exactly places digits after the decimal
+// point, rounding with mode
+func formatDecimal(r *big.Rat, places int, mode RoundingMode) string {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	num := new(big.Int).Mul(r.Num(), scale)
+	den := r.Denom()
+
+	// Euclidean division leaves 0 <= rem < den, so quo is the floor
+	quo, rem := new(big.Int).DivMod(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		twice := new(big.Int).Lsh(rem, 1)
+		half := twice.Cmp(den)
+		switch mode {
+		case RoundCeil:
+			quo.Add(quo, big.NewInt(1))
+		case RoundHalfUp:
+			if half >= 0 {
+				quo.Add(quo, big.NewInt(1))
+			}
+		case RoundHalfEven:
+			if half > 0 || (half == 0 && quo.Bit(0) == 1) {
+				quo.Add(quo, big.NewInt(1))
+			}
+		case RoundHalfAwayFromZero:
+			// For a negative value the floor already lies away from zero
+			if half > 0 || (half == 0 && num.Sign() > 0) {
+				quo.Add(quo, big.NewInt(1))
+			}
+		}
+	}
+
+	sign := ""
+	if quo.Sign() < 0 {
+		sign = "-"
+		quo.Neg(quo)
+	}
+	digits := quo.String()
+	if places == 0 {
+		return sign + digits
+	}
+	if len(digits) <= places {
+		digits = strings.Repeat("0", places-len(digits)+1) + digits
+	}
+	split := len(digits) - places
+	return sign + digits[:split] + "." + digits[split:]
+}
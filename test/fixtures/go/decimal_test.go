@@ -0,0 +1,141 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddStrings(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.AddStrings("0.1", "0.2")
+	require.NoError(t, err)
+	assert.Equal(t, "0.30", result)
+
+	result, err = calc.AddStrings("-1.5", "0.25")
+	require.NoError(t, err)
+	assert.Equal(t, "-1.25", result)
+}
+
+func TestDecimalStringOps(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		name     string
+		op       func(a, b string) (string, error)
+		a, b     string
+		expected string
+	}{
+		{"subtract", calc.SubtractStrings, "1.00", "0.99", "0.01"},
+		{"multiply", calc.MultiplyStrings, "1.1", "1.1", "1.21"},
+		{"multiply rounds", calc.MultiplyStrings, "0.125", "1", "0.13"},
+		{"divide", calc.DivideStrings, "1", "3", "0.33"},
+		{"divide negative", calc.DivideStrings, "-2", "3", "-0.67"},
+		{"exponent", calc.AddStrings, "1e3", "1e-3", "1000.00"},
+		{"large", calc.AddStrings, "12345678901234567890.01", "0.01", "12345678901234567890.02"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.op(tt.a, tt.b)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestDecimalStringsRoundingMode(t *testing.T) {
+	calc := NewCalculator(WithRoundingMode(RoundHalfEven))
+	result, err := calc.AddStrings("0.125", "0")
+	require.NoError(t, err)
+	assert.Equal(t, "0.12", result)
+
+	calc = NewCalculator(WithPrecision(0), WithRoundingMode(RoundFloor))
+	result, err = calc.SubtractStrings("0", "0.5")
+	require.NoError(t, err)
+	assert.Equal(t, "-1", result)
+
+	calc = NewCalculator(WithRoundingMode(RoundCeil))
+	result, err = calc.SubtractStrings("0", "0.001")
+	require.NoError(t, err)
+	assert.Equal(t, "0.00", result)
+}
+
+func TestDecimalStringsErrors(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.AddStrings("abc", "1")
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+	_, err = calc.AddStrings("1", "1/3")
+	assert.ErrorIs(t, err, ErrInvalidNumber)
+	for _, s := range []string{"0x10", "0b101", "0o17", "1_000", "inf", "NaN", "", "-", ".", "1e", "1e+", "1.2.3", "--1"} {
+		_, err = calc.AddStrings(s, "1")
+		assert.ErrorIs(t, err, ErrInvalidNumber, "input %q", s)
+	}
+	_, err = calc.DivideStrings("1", "0")
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestDecimalStringsAcceptDecimalGrammar(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"12", "13.00"},
+		{"-12.50", "-11.50"},
+		{"+0.5", "1.50"},
+		{".5", "1.50"},
+		{"5.", "6.00"},
+		{"1e-3", "1.00"},
+		{"2.5E+1", "26.00"},
+		{" 3 ", "4.00"},
+	}
+	for _, tt := range tests {
+		result, err := calc.AddStrings(tt.input, "1")
+		require.NoError(t, err, "input %q", tt.input)
+		assert.Equal(t, tt.expected, result, "input %q", tt.input)
+	}
+}
+
+func TestDivideDetailed(t *testing.T) {
+	calc := NewCalculator()
+	result, exact, err := calc.DivideDetailed(1, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 0.25, result)
+	assert.True(t, exact)
+
+	result, exact, err = calc.DivideDetailed(1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0.33, result)
+	assert.False(t, exact)
+
+	_, exact, err = calc.DivideDetailed(1, 0)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	assert.False(t, exact)
+}
+
+func TestDivideDetailedIgnoresBinaryNoise(t *testing.T) {
+	calc := NewCalculator()
+	result, exact, err := calc.DivideDetailed(0.3, 0.1)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, result)
+	assert.True(t, exact)
+
+	_, exact, err = calc.DivideDetailed(1, 8)
+	require.NoError(t, err)
+	assert.False(t, exact, "0.125 needs three places")
+
+	_, exact, err = NewCalculator(WithPrecision(3)).DivideDetailed(1, 8)
+	require.NoError(t, err)
+	assert.True(t, exact)
+}
+
+func TestDecimalStringsHalfAwayFromZero(t *testing.T) {
+	calc := NewCalculator(WithPrecision(0), WithRoundingMode(RoundHalfAwayFromZero))
+	for _, tt := range []struct{ a, expected string }{
+		{"2.5", "3"}, {"-2.5", "-3"}, {"0.5", "1"}, {"-0.5", "-1"}, {"-2.4", "-2"},
+	} {
+		result, err := calc.AddStrings(tt.a, "0")
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, result, tt.a)
+	}
+}
@@ -0,0 +1,24 @@
+package calculator
+
+import "fmt"
+
+// DepreciationSchedule returns the straight-line book value of an asset at
+// the end of each of years years. The final value is exactly salvage.
+func DepreciationSchedule(cost, salvage float64, years int) ([]float64, error) {
+	if years <= 0 {
+		return nil, fmt.Errorf("%w: years must be positive", ErrInvalidArgument)
+	}
+	if cost < 0 || salvage < 0 {
+		return nil, fmt.Errorf("%w: cost and salvage must not be negative", ErrInvalidArgument)
+	}
+	if salvage > cost {
+		return nil, fmt.Errorf("%w: salvage must not exceed cost", ErrInvalidArgument)
+	}
+	annual := (cost - salvage) / float64(years)
+	schedule := make([]float64, years)
+	for i := range schedule {
+		schedule[i] = cost - annual*float64(i+1)
+	}
+	schedule[years-1] = salvage
+	return schedule, nil
+}
@@ -0,0 +1,35 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepreciationSchedule(t *testing.T) {
+	schedule, err := DepreciationSchedule(1000, 100, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{700, 400, 100}, schedule)
+}
+
+func TestDepreciationScheduleEndsAtSalvage(t *testing.T) {
+	schedule, err := DepreciationSchedule(1, 0.1, 7)
+	require.NoError(t, err)
+	require.Len(t, schedule, 7)
+	assert.Equal(t, 0.1, schedule[6])
+	for i := 1; i < len(schedule); i++ {
+		assert.Less(t, schedule[i], schedule[i-1])
+	}
+}
+
+func TestDepreciationScheduleInvalid(t *testing.T) {
+	_, err := DepreciationSchedule(1000, 100, 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = DepreciationSchedule(100, 1000, 3)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = DepreciationSchedule(-1000, 100, 3)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = DepreciationSchedule(1000, -100, 3)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
@@ -0,0 +1,162 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ApplyDiscount is the precision-aware form of the package-level
+// ApplyDiscount, rounding the discounted price to the calculator's precision
+// so results like 89.99999999 come out as 90
+func (c *Calculator) ApplyDiscount(price, discountPercent float64) float64 {
+	result := c.round(ApplyDiscount(price, discountPercent))
+	c.record("ApplyDiscount", result, price, discountPercent)
+	return result
+}
+
+// ApplyDiscountChecked is the precision-aware form of the package-level
+// ApplyDiscountChecked, with the same validation errors
+func (c *Calculator) ApplyDiscountChecked(price, discountPercent float64) (float64, error) {
+	if err := c.checkOperands(price, discountPercent); err != nil {
+		return 0, err
+	}
+	result, err := ApplyDiscountChecked(price, discountPercent)
+	if err != nil {
+		return 0, err
+	}
+	result = c.round(result)
+	c.record("ApplyDiscount", result, price, discountPercent)
+	return result, nil
+}
+
+// ApplyDiscountGeneric applies a discount percentage to a price of any
+// integer or float type, returning the same type. Integer prices such as
+// cents are rounded to the nearest unit, halves away from zero; float prices
+// are not rounded, as with ApplyDiscount.
+func ApplyDiscountGeneric[T ~int | ~int64 | ~float64](price T, discountPercent float64) T {
+	result := ApplyDiscount(float64(price), discountPercent)
+	if half := 0.5; T(half) == 0 {
+		// T truncates, so it is an integer type
+		return T(math.Round(result))
+	}
+	return T(result)
+}
+
+// ApplyDiscountChecked applies a discount percentage to a price, returning
+// ErrInvalidDiscount for a percent outside [0, 100] and ErrNegativePrice for
+// a negative price
+func ApplyDiscountChecked(price, discountPercent float64) (float64, error) {
+	if price < 0 {
+		return 0, ErrNegativePrice
+	}
+	if !validDiscount(discountPercent) {
+		return 0, ErrInvalidDiscount
+	}
+	return ApplyDiscount(price, discountPercent), nil
+}
+
+// ApplyDiscountClamped applies a discount percentage with the percent capped
+// to [0, 100], so the result never drops below 0 or exceeds price. This is
+// the most forgiving variant, meant for UI input.
+func ApplyDiscountClamped(price, discountPercent float64) float64 {
+	return ApplyDiscount(price, math.Max(0, math.Min(100, discountPercent)))
+}
+
+// ApplyDiscounts applies each discount percentage in order, each one to the
+// already-reduced price, so 10% then 5% off 100 yields 85.5 rather than 85.
+// If any percentage is outside [0, 100] the price is returned unchanged.
+func ApplyDiscounts(price float64, discountPercents ...float64) float64 {
+	for _, percent := range discountPercents {
+		if !validDiscount(percent) {
+			return price
+		}
+	}
+	result := price
+	for _, percent := range discountPercents {
+		result = ApplyDiscount(result, percent)
+	}
+	return result
+}
+
+// OriginalFromDiscounted recovers the price before a discount was applied,
+// inverting ApplyDiscount. A 100% discount cannot be inverted and returns
+// ErrDivisionByZero.
+func OriginalFromDiscounted(discountedPrice, discountPercent float64) (float64, error) {
+	if !validDiscount(discountPercent) {
+		return 0, ErrInvalidDiscount
+	}
+	if discountPercent == 100 {
+		return 0, ErrDivisionByZero
+	}
+	return discountedPrice / (1 - discountPercent/100), nil
+}
+
+// ApplyDiscountAll applies the same discount percentage to every price,
+// returning a new slice and leaving prices untouched
+func ApplyDiscountAll(prices []float64, discountPercent float64) ([]float64, error) {
+	if !validDiscount(discountPercent) {
+		return nil, ErrInvalidDiscount
+	}
+	discounted := make([]float64, len(prices))
+	for i, price := range prices {
+		discounted[i] = ApplyDiscount(price, discountPercent)
+	}
+	return discounted, nil
+}
+
+// ApplyDiscountsPerItem applies discountPercents[i] to prices[i], returning a
+// new slice. The slices must be the same length and every percent must be in
+// [0, 100]; the error for an invalid percent names its index.
+func ApplyDiscountsPerItem(prices, discountPercents []float64) ([]float64, error) {
+	if len(prices) != len(discountPercents) {
+		return nil, fmt.Errorf("%w: %d prices but %d discounts", ErrInvalidArgument, len(prices), len(discountPercents))
+	}
+	discounted := make([]float64, len(prices))
+	for i, price := range prices {
+		if !validDiscount(discountPercents[i]) {
+			return nil, fmt.Errorf("item %d: %w", i, ErrInvalidDiscount)
+		}
+		discounted[i] = ApplyDiscount(price, discountPercents[i])
+	}
+	return discounted, nil
+}
+
+// DiscountTier grants Percent off to prices of at least MinAmount
+type DiscountTier struct {
+	MinAmount float64
+	Percent   float64
+}
+
+// ApplyTieredDiscount applies the discount of the highest tier whose MinAmount
+// the pre-discount price reaches. Tiers may be given in any order; two tiers
+// with the same MinAmount are ambiguous and rejected with ErrInvalidArgument.
+// A price below every tier is returned unchanged.
+func ApplyTieredDiscount(price float64, tiers []DiscountTier) (float64, error) {
+	if price < 0 {
+		return 0, ErrNegativePrice
+	}
+	sorted := make([]DiscountTier, len(tiers))
+	copy(sorted, tiers)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].MinAmount < sorted[j].MinAmount })
+
+	for i, tier := range sorted {
+		if !validDiscount(tier.Percent) {
+			return 0, fmt.Errorf("tier at %g: %w", tier.MinAmount, ErrInvalidDiscount)
+		}
+		if i > 0 && tier.MinAmount == sorted[i-1].MinAmount {
+			return 0, fmt.Errorf("%w: duplicate discount tier at %g", ErrInvalidArgument, tier.MinAmount)
+		}
+	}
+
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if price >= sorted[i].MinAmount {
+			return ApplyDiscount(price, sorted[i].Percent), nil
+		}
+	}
+	return price, nil
+}
+
+func validDiscount(percent float64) bool {
+	return percent >= 0 && percent <= 100
+}
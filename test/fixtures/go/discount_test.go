@@ -0,0 +1,219 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculatorApplyDiscount(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		price, percent, expected float64
+	}{
+		{0, 50, 0},
+		{100, 0, 100},
+		{100, 10, 90},
+		{19.99, 15, 16.99},
+		{0.3, 33, 0.2},
+		{59.99, 100, 0},
+		{1234.56, 12.5, 1080.24},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, calc.ApplyDiscount(tt.price, tt.percent), "%v off %v", tt.percent, tt.price)
+
+		result, err := calc.ApplyDiscountChecked(tt.price, tt.percent)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, result, "checked %v off %v", tt.percent, tt.price)
+	}
+	assert.Len(t, calc.History(), 2*len(tests))
+}
+
+func TestCalculatorApplyDiscountChecked(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.ApplyDiscountChecked(-1, 10)
+	assert.ErrorIs(t, err, ErrNegativePrice)
+	_, err = calc.ApplyDiscountChecked(100, 101)
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+	assert.Empty(t, calc.History())
+
+	_, err = NewCalculator(WithStrictFinite(true)).ApplyDiscountChecked(math.Inf(1), 10)
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func TestApplyDiscountGenericIntCents(t *testing.T) {
+	assert.Equal(t, 9000, ApplyDiscountGeneric(10000, 10))
+	assert.Equal(t, int64(6668), ApplyDiscountGeneric(int64(10001), 33.33))
+	assert.Equal(t, 2, ApplyDiscountGeneric(3, 50), "half a cent rounds away from zero")
+
+	type cents int
+	assert.Equal(t, cents(1999), ApplyDiscountGeneric(cents(2499), 20))
+}
+
+func TestApplyDiscountGenericFloatDollars(t *testing.T) {
+	assert.InDelta(t, 90.0, ApplyDiscountGeneric(100.0, 10), 1e-9)
+	assert.InDelta(t, 1.25, ApplyDiscountGeneric(2.5, 50), 1e-9)
+
+	type dollars float64
+	assert.InDelta(t, 19.992, float64(ApplyDiscountGeneric(dollars(24.99), 20)), 1e-9)
+}
+
+func TestApplyDiscountCheckedBoundaries(t *testing.T) {
+	result, err := ApplyDiscountChecked(100, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, result)
+
+	result, err = ApplyDiscountChecked(100, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, result)
+}
+
+func TestApplyDiscountCheckedInvalidPercent(t *testing.T) {
+	_, err := ApplyDiscountChecked(100, 150)
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+
+	_, err = ApplyDiscountChecked(100, -10)
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+}
+
+func TestApplyDiscountCheckedNegativePrice(t *testing.T) {
+	_, err := ApplyDiscountChecked(-1, 10)
+	assert.ErrorIs(t, err, ErrNegativePrice)
+}
+
+func TestApplyDiscountClamped(t *testing.T) {
+	assert.Equal(t, 0.0, ApplyDiscountClamped(100, 150))
+	assert.Equal(t, 100.0, ApplyDiscountClamped(100, -20))
+	assert.Equal(t, 75.0, ApplyDiscountClamped(100, 25))
+}
+
+func TestApplyDiscountsStacks(t *testing.T) {
+	assert.InDelta(t, 85.5, ApplyDiscounts(100, 10, 5), 1e-9)
+}
+
+func TestApplyDiscountsEmpty(t *testing.T) {
+	assert.Equal(t, 100.0, ApplyDiscounts(100))
+}
+
+func TestApplyDiscountsSingleMatchesApplyDiscount(t *testing.T) {
+	assert.Equal(t, ApplyDiscount(80, 25), ApplyDiscounts(80, 25))
+}
+
+func TestApplyDiscountsOutOfRange(t *testing.T) {
+	assert.Equal(t, 100.0, ApplyDiscounts(100, 10, 150))
+	assert.Equal(t, 100.0, ApplyDiscounts(100, -5, 10))
+}
+
+func TestApplyTieredDiscount(t *testing.T) {
+	tiers := []DiscountTier{
+		{MinAmount: 0, Percent: 0},
+		{MinAmount: 100, Percent: 10},
+		{MinAmount: 500, Percent: 20},
+	}
+	tests := []struct{ price, expected float64 }{
+		{50, 50},
+		{99.99, 99.99},
+		{100, 90},
+		{499, 449.1},
+		{500, 400},
+		{1000, 800},
+	}
+	for _, tt := range tests {
+		result, err := ApplyTieredDiscount(tt.price, tiers)
+		require.NoError(t, err)
+		assert.InDelta(t, tt.expected, result, 1e-9, "price %v", tt.price)
+	}
+}
+
+func TestApplyTieredDiscountUnsortedTiers(t *testing.T) {
+	tiers := []DiscountTier{
+		{MinAmount: 500, Percent: 20},
+		{MinAmount: 100, Percent: 10},
+	}
+	result, err := ApplyTieredDiscount(200, tiers)
+	require.NoError(t, err)
+	assert.InDelta(t, 180.0, result, 1e-9)
+
+	result, err = ApplyTieredDiscount(50, tiers)
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, result)
+}
+
+func TestApplyTieredDiscountInvalidTiers(t *testing.T) {
+	_, err := ApplyTieredDiscount(200, []DiscountTier{{MinAmount: 100, Percent: 10}, {MinAmount: 100, Percent: 15}})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = ApplyTieredDiscount(200, []DiscountTier{{MinAmount: 100, Percent: 120}})
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+
+	_, err = ApplyTieredDiscount(-1, nil)
+	assert.ErrorIs(t, err, ErrNegativePrice)
+}
+
+func TestApplyDiscountAll(t *testing.T) {
+	prices := []float64{100, 50, 20}
+	result, err := ApplyDiscountAll(prices, 10)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{90, 45, 18}, result, 1e-9)
+	assert.Equal(t, []float64{100, 50, 20}, prices)
+}
+
+func TestApplyDiscountAllEmpty(t *testing.T) {
+	result, err := ApplyDiscountAll(nil, 10)
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
+
+func TestApplyDiscountAllInvalidPercent(t *testing.T) {
+	_, err := ApplyDiscountAll([]float64{100}, 101)
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+}
+
+func TestApplyDiscountsPerItem(t *testing.T) {
+	prices := []float64{100, 50, 20}
+	percents := []float64{10, 0, 50}
+	result, err := ApplyDiscountsPerItem(prices, percents)
+	require.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{90, 50, 10}, result, 1e-9)
+	assert.Equal(t, []float64{100, 50, 20}, prices)
+	assert.Equal(t, []float64{10, 0, 50}, percents)
+}
+
+func TestApplyDiscountsPerItemLengthMismatch(t *testing.T) {
+	_, err := ApplyDiscountsPerItem([]float64{100, 50}, []float64{10})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestApplyDiscountsPerItemInvalidPercent(t *testing.T) {
+	_, err := ApplyDiscountsPerItem([]float64{100, 50}, []float64{10, 120})
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+	assert.Contains(t, err.Error(), "item 1")
+}
+
+func TestOriginalFromDiscounted(t *testing.T) {
+	result, err := OriginalFromDiscounted(90, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, result)
+
+	result, err = OriginalFromDiscounted(ApplyDiscount(80, 25), 25)
+	require.NoError(t, err)
+	assert.InDelta(t, 80.0, result, 1e-9)
+
+	result, err = OriginalFromDiscounted(42, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, result)
+}
+
+func TestOriginalFromDiscountedErrors(t *testing.T) {
+	_, err := OriginalFromDiscounted(0, 100)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+
+	_, err = OriginalFromDiscounted(90, 110)
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+
+	_, err = OriginalFromDiscounted(90, -10)
+	assert.ErrorIs(t, err, ErrInvalidDiscount)
+}
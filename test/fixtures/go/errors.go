@@ -0,0 +1,65 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned by calculator operations. Functions may wrap
+// them, so compare with errors.Is rather than ==.
+var (
+	// ErrDivisionByZero is returned when dividing by zero
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrZeroToNegativePower is returned when raising zero to a negative power
+	ErrZeroToNegativePower = errors.New("zero raised to a negative power")
+	// ErrNegativeSqrt is returned when taking the square root of a negative number
+	ErrNegativeSqrt = errors.New("square root of negative number")
+	// ErrNegativeEvenRoot is returned when taking an even root of a negative number
+	ErrNegativeEvenRoot = errors.New("even root of negative number")
+	// ErrInvalidPrecision is returned when a precision is outside [0, MaxPrecision]
+	ErrInvalidPrecision = errors.New("invalid precision")
+	// ErrInvalidDiscount is returned when a discount percentage is outside [0, 100]
+	ErrInvalidDiscount = errors.New("discount percent must be between 0 and 100")
+	// ErrNegativePrice is returned when a price is negative
+	ErrNegativePrice = errors.New("price must not be negative")
+	// ErrOverflow is returned when a result is too large to represent
+	ErrOverflow = errors.New("result overflows")
+	// ErrNotFinite is returned when an operand or result is NaN or infinite
+	ErrNotFinite = errors.New("value is not finite")
+	// ErrEmptyInput is returned when an operation needs at least one value
+	ErrEmptyInput = errors.New("no values provided")
+	// ErrInsufficientData is returned when a sample statistic has fewer than two values
+	ErrInsufficientData = errors.New("at least two values required")
+	// ErrNonPositiveLog is returned when taking the logarithm of zero or a negative number
+	ErrNonPositiveLog = errors.New("logarithm of non-positive number")
+	// ErrInvalidLogBase is returned when a logarithm base is non-positive or one
+	ErrInvalidLogBase = errors.New("logarithm base must be positive and not 1")
+	// ErrInvalidArgument is wrapped with details when an argument is out of range
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrUnknownOperation is returned when an operation name is not recognised
+	ErrUnknownOperation = errors.New("unknown operation")
+	// ErrInvalidNumber is returned when a string cannot be parsed as a number
+	ErrInvalidNumber = errors.New("invalid number")
+	// ErrExpressionTooDeep is returned when an expression nests deeper than the calculator allows
+	ErrExpressionTooDeep = errors.New("expression nested too deeply")
+	// ErrUnknownCurrency is returned when a currency code is not in the rate table
+	ErrUnknownCurrency = errors.New("unknown currency")
+)
+
+// CalcError records the operation and operands that caused an error.
+// It wraps a sentinel so errors.Is(err, ErrDivisionByZero) still matches.
+type CalcError struct {
+	Op  string
+	A   float64
+	B   float64
+	Err error
+}
+
+func (e *CalcError) Error() string {
+	return fmt.Sprintf("%s(%g, %g): %v", e.Op, e.A, e.B, e.Err)
+}
+
+// Unwrap returns the underlying sentinel error
+func (e *CalcError) Unwrap() error {
+	return e.Err
+}
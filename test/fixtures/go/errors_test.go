@@ -0,0 +1,53 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDivideErrorIs(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Divide(1, 0)
+	assert.True(t, errors.Is(err, ErrDivisionByZero))
+}
+
+func TestSentinelErrorsAreDistinct(t *testing.T) {
+	sentinels := []error{
+		ErrDivisionByZero,
+		ErrZeroToNegativePower,
+		ErrNegativeSqrt,
+		ErrInvalidPrecision,
+		ErrInvalidDiscount,
+		ErrNegativePrice,
+		ErrOverflow,
+		ErrNotFinite,
+		ErrEmptyInput,
+		ErrInsufficientData,
+		ErrUnknownCurrency,
+		ErrExpressionTooDeep,
+	}
+	for i, a := range sentinels {
+		assert.NotEmpty(t, a.Error())
+		for j, b := range sentinels {
+			if i != j {
+				assert.False(t, errors.Is(a, b), "%v should not match %v", a, b)
+			}
+		}
+	}
+}
+
+func TestDivideReturnsCalcError(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Divide(7.5, 0)
+
+	var calcErr *CalcError
+	require.True(t, errors.As(err, &calcErr))
+	assert.Equal(t, "Divide", calcErr.Op)
+	assert.Equal(t, 7.5, calcErr.A)
+	assert.Equal(t, 0.0, calcErr.B)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	assert.Equal(t, "Divide(7.5, 0): division by zero", err.Error())
+}
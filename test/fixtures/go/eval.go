@@ -0,0 +1,426 @@
+package calculator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// DefaultMaxDepth is how deeply Eval expressions may nest unless changed
+// with WithMaxDepth
+const DefaultMaxDepth = 256
+
+// ParseError describes malformed input passed to Eval
+type ParseError struct {
+	Pos int // byte offset into the expression
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse error at position %d: %s", e.Pos, e.Msg)
+}
+
+// Eval evaluates an infix expression such as "2 + 3 * (4 - 1)".
+// It supports +, -, *, /, parentheses, unary minus and calls to functions
+// such as "sqrt(16)" or any registered with RegisterFunc. Intermediate values
+// keep full precision; only the final result is rounded to the calculator's
+// precision. A result that overflows to ±Inf or NaN is reported as
+// ErrNotFinite rather than returned, and nesting deeper than WithMaxDepth
+// allows fails with ErrExpressionTooDeep.
+//
+// A postfix % marks a percentage, not a modulo. It binds tighter than any
+// binary operator, so "10%" on its own or as a factor is 0.1 ("2 * 50%" is 1).
+// When a percentage is the whole right-hand term of + or -, it is taken
+// relative to the left operand, as on a desk calculator: "200 + 10%" is 220
+// and "200 - 10%" is 180.
+func (c *Calculator) Eval(expr string) (float64, error) {
+	return c.EvalContext(context.Background(), expr)
+}
+
+// EvalContext is like Eval but stops with ctx.Err() once ctx is cancelled.
+// The context is checked up front, so a cached result is never returned for
+// a cancelled context, and then between tokens and before each operand.
+func (c *Calculator) EvalContext(ctx context.Context, expr string) (float64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.memoize(func() (float64, error) {
+		return c.evalUnrounded(&parser{ctx: ctx}, expr)
+	}, "Eval", expr)
+}
+
+// EvalVars is like Eval but resolves identifiers against vars, so
+// "price * (1 - discount/100)" can be evaluated for any price and discount.
+// An identifier followed by "(" is always a function call, so a variable may
+// share its name with a function. Variable names must be identifiers, and an
+// identifier missing from vars is reported as a ParseError naming it.
+func (c *Calculator) EvalVars(expr string, vars map[string]float64) (float64, error) {
+	if err := validateNames("variable", vars); err != nil {
+		return 0, err
+	}
+	return c.eval(&parser{ctx: context.Background(), vars: vars}, expr)
+}
+
+// validateNames checks that every key of names is an identifier
+func validateNames[V any](kind string, names map[string]V) error {
+	for name := range names {
+		if !isIdentifier(name) {
+			return fmt.Errorf("%w: %s name %q is not an identifier", ErrInvalidArgument, kind, name)
+		}
+	}
+	return nil
+}
+
+// EvalWithWarnings is like Eval but also reports numeric quality issues met
+// along the way, such as "division 1/3 rounded" for a quotient that does not
+// terminate within the calculator's precision, or "result overflowed". Any
+// warnings gathered before an error are returned with it.
+func (c *Calculator) EvalWithWarnings(expr string) (float64, []string, error) {
+	p := &parser{ctx: context.Background(), warn: true}
+	value, err := c.eval(p, expr)
+	return value, p.warnings, err
+}
+
+// eval parses and evaluates expr with p, which the caller configures with a
+// context and any variables or warning collection
+func (c *Calculator) eval(p *parser, expr string) (float64, error) {
+	value, err := c.evalUnrounded(p, expr)
+	if err != nil {
+		return 0, err
+	}
+	return c.round(value), nil
+}
+
+// evalUnrounded is eval without rounding the final result
+func (c *Calculator) evalUnrounded(p *parser, expr string) (float64, error) {
+	tokens, err := tokenize(p.ctx, expr)
+	if err != nil {
+		return 0, err
+	}
+	c.mu.RLock()
+	p.calc, p.tokens, p.maxDepth = c, tokens, c.maxDepth
+	c.mu.RUnlock()
+	value, err := p.parseExpr()
+	if err != nil {
+		return 0, err
+	}
+	if tok := p.peek(); tok.kind != tokEOF {
+		return 0, &ParseError{Pos: tok.pos, Msg: fmt.Sprintf("unexpected %q", tok.text)}
+	}
+	if !isFinite(value) {
+		p.warnf("result overflowed")
+		return 0, ErrNotFinite
+	}
+	return value, nil
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokOperator
+	tokLParen
+	tokRParen
+	tokComma
+	tokIdent
+	tokPercent
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value float64
+	pos   int
+}
+
+func tokenize(ctx context.Context, expr string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		ch := expr[i]
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case isDigit(ch) || ch == '.':
+			start := i
+			for i < len(expr) && (isDigit(expr[i]) || expr[i] == '.') {
+				i++
+			}
+			text := expr[start:i]
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, &ParseError{Pos: start, Msg: fmt.Sprintf("invalid number %q", text)}
+			}
+			tokens = append(tokens, token{kind: tokNumber, text: text, value: value, pos: start})
+		case ch == '+' || ch == '-' || ch == '*' || ch == '/':
+			tokens = append(tokens, token{kind: tokOperator, text: string(ch), pos: i})
+			i++
+		case isIdentStart(ch):
+			start := i
+			for i < len(expr) && (isIdentStart(expr[i]) || isDigit(expr[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokIdent, text: expr[start:i], pos: start})
+		case ch == '%':
+			tokens = append(tokens, token{kind: tokPercent, text: "%", pos: i})
+			i++
+		case ch == ',':
+			tokens = append(tokens, token{kind: tokComma, text: ",", pos: i})
+			i++
+		case ch == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i})
+			i++
+		case ch == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i})
+			i++
+		default:
+			return nil, &ParseError{Pos: i, Msg: fmt.Sprintf("unexpected character %q", ch)}
+		}
+	}
+	return append(tokens, token{kind: tokEOF, text: "end of input", pos: len(expr)}), nil
+}
+
+// formatOperand renders x as briefly as possible for warning messages
+func formatOperand(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+func isIdentStart(ch byte) bool {
+	return ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
+
+// isIdentifier reports whether name would tokenize as a single identifier
+func isIdentifier(name string) bool {
+	if name == "" || !isIdentStart(name[0]) {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		if !isIdentStart(name[i]) && !isDigit(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// parser is a recursive-descent parser over the grammar:
+//
+//	expr    = term { ("+" | "-") term }
+//	term    = unary { ("*" | "/") unary }
+//	unary   = ("-" | "+") unary | primary [ "%" ]
+//	primary = number | "(" expr ")" | ident "(" [ expr { "," expr } ] ")" | ident
+type parser struct {
+	ctx      context.Context
+	calc     *Calculator
+	vars     map[string]float64
+	tokens   []token
+	pos      int
+	depth    int // current nesting of parentheses, calls and unary signs
+	maxDepth int
+	warn     bool     // collect warnings
+	warnings []string // populated only when warn is set
+}
+
+// enter descends one nesting level, failing once maxDepth is exceeded so
+// hostile input cannot exhaust the stack. Callers must defer p.leave().
+func (p *parser) enter(tok token) error {
+	p.depth++
+	if p.depth > p.maxDepth {
+		return fmt.Errorf("%w: more than %d levels at position %d", ErrExpressionTooDeep, p.maxDepth, tok.pos)
+	}
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+func (p *parser) warnf(format string, args ...any) {
+	if p.warn {
+		p.warnings = append(p.warnings, fmt.Sprintf(format, args...))
+	}
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) parseExpr() (float64, error) {
+	left, _, err := p.parseTerm()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != tokOperator || (tok.text != "+" && tok.text != "-") {
+			return left, nil
+		}
+		p.next()
+		right, percent, err := p.parseTerm()
+		if err != nil {
+			return 0, err
+		}
+		if percent {
+			// "a + b%" adds b percent of a
+			right *= left
+		}
+		if tok.text == "+" {
+			left += right
+		} else {
+			left -= right
+		}
+	}
+}
+
+// parseTerm reports percent when the term is a lone percentage such as "10%",
+// so that parseExpr can apply it relative to the left operand
+func (p *parser) parseTerm() (float64, bool, error) {
+	left, percent, err := p.parseUnary()
+	if err != nil {
+		return 0, false, err
+	}
+	for {
+		tok := p.peek()
+		if tok.kind != tokOperator || (tok.text != "*" && tok.text != "/") {
+			return left, percent, nil
+		}
+		p.next()
+		percent = false
+		right, _, err := p.parseUnary()
+		if err != nil {
+			return 0, false, err
+		}
+		if tok.text == "*" {
+			left *= right
+			continue
+		}
+		if right == 0 {
+			return 0, false, ErrDivisionByZero
+		}
+		if p.warn && !terminatesWithin(left, right, p.calc.Precision()) {
+			p.warnf("division %s/%s rounded", formatOperand(left), formatOperand(right))
+		}
+		left /= right
+	}
+}
+
+func (p *parser) parseUnary() (float64, bool, error) {
+	if err := p.ctx.Err(); err != nil {
+		return 0, false, err
+	}
+	tok := p.peek()
+	if tok.kind == tokOperator && (tok.text == "-" || tok.text == "+") {
+		p.next()
+		if err := p.enter(tok); err != nil {
+			return 0, false, err
+		}
+		defer p.leave()
+		value, percent, err := p.parseUnary()
+		if err != nil {
+			return 0, false, err
+		}
+		if tok.text == "-" {
+			return -value, percent, nil
+		}
+		return value, percent, nil
+	}
+	value, err := p.parsePrimary()
+	if err != nil {
+		return 0, false, err
+	}
+	if p.peek().kind == tokPercent {
+		p.next()
+		return value / 100, true, nil
+	}
+	return value, false, nil
+}
+
+func (p *parser) parsePrimary() (float64, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokNumber:
+		return tok.value, nil
+	case tokLParen:
+		if err := p.enter(tok); err != nil {
+			return 0, err
+		}
+		defer p.leave()
+		value, err := p.parseExpr()
+		if err != nil {
+			return 0, err
+		}
+		if closing := p.next(); closing.kind != tokRParen {
+			return 0, &ParseError{Pos: closing.pos, Msg: fmt.Sprintf("expected ')' but found %q", closing.text)}
+		}
+		return value, nil
+	case tokIdent:
+		if p.peek().kind == tokLParen {
+			if err := p.enter(tok); err != nil {
+				return 0, err
+			}
+			defer p.leave()
+			return p.parseCall(tok)
+		}
+		return p.parseVar(tok)
+	default:
+		return 0, &ParseError{Pos: tok.pos, Msg: fmt.Sprintf("expected a number or '(' but found %q", tok.text)}
+	}
+}
+
+func (p *parser) parseVar(name token) (float64, error) {
+	value, ok := p.vars[name.text]
+	if ok {
+		return value, nil
+	}
+	if _, isFunc := p.calc.lookupFunc(name.text); isFunc {
+		next := p.peek()
+		return 0, &ParseError{Pos: next.pos, Msg: fmt.Sprintf("expected '(' after %q but found %q", name.text, next.text)}
+	}
+	return 0, &ParseError{Pos: name.pos, Msg: fmt.Sprintf("undefined variable %q", name.text)}
+}
+
+func (p *parser) parseCall(name token) (float64, error) {
+	fn, ok := p.calc.lookupFunc(name.text)
+	if !ok {
+		return 0, &ParseError{Pos: name.pos, Msg: fmt.Sprintf("unknown function %q", name.text)}
+	}
+	p.next() // "(", checked by parsePrimary
+
+	var args []float64
+	if p.peek().kind != tokRParen {
+		for {
+			arg, err := p.parseExpr()
+			if err != nil {
+				return 0, err
+			}
+			args = append(args, arg)
+			if p.peek().kind != tokComma {
+				break
+			}
+			p.next()
+		}
+	}
+	if closing := p.next(); closing.kind != tokRParen {
+		return 0, &ParseError{Pos: closing.pos, Msg: fmt.Sprintf("expected ',' or ')' but found %q", closing.text)}
+	}
+
+	value, err := fn(args...)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", name.text, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,260 @@
+package calculator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvalPrecedence(t *testing.T) {
+	calc := NewCalculator()
+	tests := map[string]float64{
+		"2 + 3 * (4 - 1)": 11,
+		"2 + 3 * 4":       14,
+		"10 - 4 - 3":      3,
+		"8 / 4 / 2":       1,
+		"1 + 2 * 3 - 4/2": 5,
+		"0.1 + 0.2":       0.3,
+		"10 / 3":          3.33,
+	}
+	for expr, expected := range tests {
+		result, err := calc.Eval(expr)
+		require.NoError(t, err, expr)
+		assert.Equal(t, expected, result, expr)
+	}
+}
+
+func TestEvalNestedParens(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Eval("((2 + 3) * (4 - (1 + 1))) / 5")
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, result)
+}
+
+func TestEvalUnaryMinus(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Eval("-3 * -(2 + 1)")
+	require.NoError(t, err)
+	assert.Equal(t, 9.0, result)
+}
+
+func TestEvalDivisionByZero(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Eval("1 / (2 - 2)")
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestEvalParseErrors(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		expr string
+		pos  int
+	}{
+		{"", 0},
+		{"2 +", 3},
+		{"(2 + 3", 6},
+		{"2 + 3)", 5},
+		{"2 $ 3", 2},
+		{"1..2", 0},
+		{"* 2", 0},
+	}
+	for _, tt := range tests {
+		_, err := calc.Eval(tt.expr)
+		var parseErr *ParseError
+		require.True(t, errors.As(err, &parseErr), "expected ParseError for %q, got %v", tt.expr, err)
+		assert.Equal(t, tt.pos, parseErr.Pos, tt.expr)
+		assert.Contains(t, parseErr.Error(), "position")
+	}
+}
+
+func TestEvalContext(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.EvalContext(context.Background(), "6 * 7")
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, result)
+}
+
+func TestEvalContextCancelled(t *testing.T) {
+	calc := NewCalculator()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	expr := strings.Repeat("1 + ", 1000) + "1"
+	_, err := calc.EvalContext(ctx, expr)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestEvalPercent(t *testing.T) {
+	calc := NewCalculator()
+	tests := map[string]float64{
+		"200 + 10%":       220,
+		"200 - 10%":       180,
+		"50%":             0.5,
+		"2 * 50%":         1,
+		"200 * 10%":       20,
+		"10% + 5":         5.1,
+		"100 + 10% + 10%": 121,
+		"(100 + 50)%":     1.5,
+		"200 + -10%":      180,
+		"80 + 2 * 10%":    80.2,
+	}
+	for expr, expected := range tests {
+		result, err := calc.Eval(expr)
+		require.NoError(t, err, expr)
+		assert.Equal(t, expected, result, expr)
+	}
+}
+
+func TestEvalPercentErrors(t *testing.T) {
+	calc := NewCalculator()
+	var parseErr *ParseError
+	for _, expr := range []string{"%", "10%%", "% 5"} {
+		_, err := calc.Eval(expr)
+		assert.True(t, errors.As(err, &parseErr), expr)
+	}
+}
+
+func TestEvalOverflow(t *testing.T) {
+	calc := NewCalculator()
+	huge := "1" + strings.Repeat("0", 200)
+	_, err := calc.Eval(huge + " * " + huge)
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, err = calc.Eval(huge + " * " + huge + " - " + huge + " * " + huge)
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
+
+func FuzzEval(f *testing.F) {
+	for _, seed := range []string{
+		"", "2 + 3 * (4 - 1)", "-(-1)", "((1)", "1))", "1 +", "* 2", "10%%",
+		"200 + 10%", "sqrt(16)", "max(1, 2, 3)", "min()", "sqrt(", "abs(,)",
+		"1 / 0", "1..2", ".", "999999999999999999999 * 999999999999999999999",
+		"1" + strings.Repeat("0", 200) + " * 1" + strings.Repeat("0", 200),
+	} {
+		f.Add(seed)
+	}
+	calc := NewCalculator()
+	f.Fuzz(func(t *testing.T, expr string) {
+		result, err := calc.Eval(expr)
+		if err == nil && !isFinite(result) {
+			t.Fatalf("Eval(%q) = %v with no error", expr, result)
+		}
+	})
+}
+
+func TestEvalVars(t *testing.T) {
+	calc := NewCalculator()
+	vars := map[string]float64{"price": 80, "discount": 25}
+	result, err := calc.EvalVars("price * (1 - discount/100)", vars)
+	require.NoError(t, err)
+	assert.Equal(t, 60.0, result)
+
+	result, err = calc.EvalVars("_x1 + sqrt(y) + 10%", map[string]float64{"_x1": 2, "y": 9})
+	require.NoError(t, err)
+	assert.Equal(t, 5.5, result)
+}
+
+func TestEvalVarsUndefined(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.EvalVars("price * qty", map[string]float64{"price": 10})
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 8, parseErr.Pos)
+	assert.Contains(t, parseErr.Msg, `undefined variable "qty"`)
+
+	_, err = calc.Eval("x + 1")
+	require.True(t, errors.As(err, &parseErr))
+	assert.Contains(t, parseErr.Msg, `undefined variable "x"`)
+}
+
+func TestEvalVarsFunctionNameCollision(t *testing.T) {
+	calc := NewCalculator()
+	calc.RegisterFunc("rate", func(args ...float64) (float64, error) { return args[0] * 2, nil })
+	vars := map[string]float64{"rate": 3, "max": 100}
+	result, err := calc.EvalVars("rate(rate) + max(rate, max)", vars)
+	require.NoError(t, err)
+	assert.Equal(t, 106.0, result)
+
+	_, err = calc.EvalVars("rate + 1", nil)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Contains(t, parseErr.Msg, `expected '(' after "rate"`)
+}
+
+func TestEvalVarsInvalidName(t *testing.T) {
+	calc := NewCalculator()
+	for _, name := range []string{"", "1x", "a-b", "x y"} {
+		_, err := calc.EvalVars("1", map[string]float64{name: 1})
+		assert.ErrorIs(t, err, ErrInvalidArgument, name)
+	}
+}
+
+func TestEvalWithWarnings(t *testing.T) {
+	calc := NewCalculator()
+	result, warnings, err := calc.EvalWithWarnings("1/3 + 1")
+	require.NoError(t, err)
+	assert.Equal(t, 1.33, result)
+	assert.Equal(t, []string{"division 1/3 rounded"}, warnings)
+
+	result, warnings, err = calc.EvalWithWarnings("(2 + 8) / 7 * 3")
+	require.NoError(t, err)
+	assert.Equal(t, 4.29, result)
+	assert.Equal(t, []string{"division 10/7 rounded"}, warnings)
+}
+
+func TestEvalWithWarningsExact(t *testing.T) {
+	calc := NewCalculator()
+	result, warnings, err := calc.EvalWithWarnings("1/4 + 0.3/0.1 + 2 * 3")
+	require.NoError(t, err)
+	assert.Equal(t, 9.25, result)
+	assert.Empty(t, warnings)
+}
+
+func TestEvalWithWarningsOverflow(t *testing.T) {
+	huge := "1" + strings.Repeat("0", 200)
+	_, warnings, err := NewCalculator().EvalWithWarnings(huge + " * " + huge)
+	assert.ErrorIs(t, err, ErrNotFinite)
+	assert.Equal(t, []string{"result overflowed"}, warnings)
+}
+
+func nested(depth int) string {
+	return strings.Repeat("(", depth) + "1" + strings.Repeat(")", depth)
+}
+
+func TestEvalMaxDepth(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Eval(nested(DefaultMaxDepth))
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result)
+
+	_, err = calc.Eval(nested(DefaultMaxDepth + 1))
+	assert.ErrorIs(t, err, ErrExpressionTooDeep)
+
+	_, err = calc.Eval(nested(100000))
+	assert.ErrorIs(t, err, ErrExpressionTooDeep)
+
+	_, err = calc.Eval(strings.Repeat("-", 100000) + "1")
+	assert.ErrorIs(t, err, ErrExpressionTooDeep)
+}
+
+func TestWithMaxDepth(t *testing.T) {
+	calc := NewCalculator(WithMaxDepth(3))
+	result, err := calc.Eval("-sqrt((4))")
+	require.NoError(t, err)
+	assert.Equal(t, -2.0, result)
+
+	_, err = calc.Eval("-sqrt(((4)))")
+	assert.ErrorIs(t, err, ErrExpressionTooDeep)
+
+	// Siblings do not add up; only nesting counts
+	result, err = calc.Eval("((1)) + ((2)) * max((3), (4))")
+	require.NoError(t, err)
+	assert.Equal(t, 9.0, result)
+
+	calc = NewCalculator(WithMaxDepth(0))
+	_, err = calc.Eval(nested(DefaultMaxDepth))
+	require.NoError(t, err)
+}
@@ -0,0 +1,84 @@
+package calculator
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Format returns value as a decimal string with exactly the calculator's
+// precision, e.g. "3.14" or "3.00" at precision 2
+func (c *Calculator) Format(value float64) string {
+	c.mu.RLock()
+	places, mode := c.precision, c.rounding
+	c.mu.RUnlock()
+	return strconv.FormatFloat(Round(value, places, mode), 'f', places, 64)
+}
+
+// FormatSmart is like Format but drops the decimal places when value is a
+// whole number at the calculator's precision, so 42 formats as "42" and
+// 42.5 as "42.50" at precision 2
+func (c *Calculator) FormatSmart(value float64) string {
+	c.mu.RLock()
+	places, mode := c.precision, c.rounding
+	c.mu.RUnlock()
+	rounded := Round(value, places, mode)
+	if rounded == math.Trunc(rounded) {
+		places = 0
+	}
+	return strconv.FormatFloat(rounded, 'f', places, 64)
+}
+
+// FormatCurrency formats value with the symbol in front, thousands separators
+// and exactly two decimal places, e.g. "$1,234.56" or "-$5.00". The value is
+// rounded with the calculator's rounding mode regardless of its precision.
+func (c *Calculator) FormatCurrency(value float64, symbol string) string {
+	return c.FormatCurrencyLocale(value, symbol, '.', ',')
+}
+
+// FormatCurrencyLocale is like FormatCurrency with the given separators, so
+// ',' and '.' give the EU style "€1.234,56". A zero thousandsSep disables
+// grouping, as does a thousandsSep equal to decimalSep, which would make the
+// output ambiguous and which ParseNumber rejects. NaN and infinities have no digits to group and format as
+// "$NaN", "$Inf" and "-$Inf".
+func (c *Calculator) FormatCurrencyLocale(value float64, symbol string, decimalSep, thousandsSep rune) string {
+	switch {
+	case math.IsNaN(value):
+		return symbol + "NaN"
+	case math.IsInf(value, 1):
+		return symbol + "Inf"
+	case math.IsInf(value, -1):
+		return "-" + symbol + "Inf"
+	}
+	rounded := Round(value, 2, c.RoundingMode())
+	sign := ""
+	if rounded < 0 {
+		sign = "-"
+	}
+	digits := strconv.FormatFloat(math.Abs(rounded), 'f', 2, 64)
+	whole, frac, _ := strings.Cut(digits, ".")
+	group := ""
+	if thousandsSep != 0 && thousandsSep != decimalSep {
+		group = string(thousandsSep)
+	}
+	return sign + symbol + groupThousands(whole, group) + string(decimalSep) + frac
+}
+
+// groupThousands inserts sep between every group of three digits
+func groupThousands(digits, sep string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	lead := len(digits) % 3
+	if lead > 0 {
+		b.WriteString(digits[:lead])
+	}
+	for i := lead; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
@@ -0,0 +1,114 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		precision int
+		value     float64
+		expected  string
+	}{
+		{2, 3.14159, "3.14"},
+		{2, 3, "3.00"},
+		{2, -2.345, "-2.34"},
+		{2, 0, "0.00"},
+		{0, 3.14159, "3"},
+		{0, 2.5, "3"},
+		{0, -7.2, "-7"},
+		{0, 0, "0"},
+		{4, 3.14159, "3.1416"},
+		{4, -0.5, "-0.5000"},
+		{4, 0, "0.0000"},
+	}
+	for _, tt := range tests {
+		calc := NewCalculator(WithPrecision(tt.precision))
+		assert.Equal(t, tt.expected, calc.Format(tt.value), "Format(%v) at precision %d", tt.value, tt.precision)
+	}
+}
+
+func TestFormatSmart(t *testing.T) {
+	tests := []struct {
+		precision int
+		value     float64
+		expected  string
+	}{
+		{2, 42, "42"},
+		{2, 42.5, "42.50"},
+		{2, -42, "-42"},
+		{2, -0.25, "-0.25"},
+		{2, 0, "0"},
+		{2, 1.001, "1"},
+		{2, 1.006, "1.01"},
+		{4, 3.14159, "3.1416"},
+		{0, 2.5, "3"},
+	}
+	for _, tt := range tests {
+		calc := NewCalculator(WithPrecision(tt.precision))
+		assert.Equal(t, tt.expected, calc.FormatSmart(tt.value), "FormatSmart(%v) at precision %d", tt.value, tt.precision)
+	}
+}
+
+func TestFormatCurrency(t *testing.T) {
+	calc := NewCalculator(WithPrecision(4))
+	tests := []struct {
+		value    float64
+		symbol   string
+		expected string
+	}{
+		{0, "$", "$0.00"},
+		{5, "$", "$5.00"},
+		{-5, "$", "-$5.00"},
+		{999.999, "$", "$1,000.00"},
+		{1234.56, "$", "$1,234.56"},
+		{1234.5678, "€", "€1,234.57"},
+		{-1234567.891, "€", "-€1,234,567.89"},
+		{123456789012, "$", "$123,456,789,012.00"},
+		{-0.001, "$", "$0.00"},
+		{100, "", "100.00"},
+		{math.NaN(), "$", "$NaN"},
+		{math.Inf(1), "$", "$Inf"},
+		{math.Inf(-1), "$", "-$Inf"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, calc.FormatCurrency(tt.value, tt.symbol), "value %v", tt.value)
+	}
+}
+
+func TestFormatCurrencyLocale(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		value            float64
+		symbol           string
+		decimal, grouper rune
+		expected         string
+	}{
+		{1234.56, "$", '.', ',', "$1,234.56"},
+		{1234.56, "€", ',', '.', "€1.234,56"},
+		{-1234567.891, "€", ',', '.', "-€1.234.567,89"},
+		{999.5, "€", ',', '.', "€999,50"},
+		{1234567.5, "CHF ", '.', '\'', "CHF 1'234'567.50"},
+		{1234567.5, "", ',', ' ', "1 234 567,50"},
+		{1234567.5, "", ',', 0, "1234567,50"},
+		{1234.5, "$", '.', '.', "$1234.50"},
+		{math.NaN(), "€", ',', '.', "€NaN"},
+		{math.Inf(-1), "€", ',', '.', "-€Inf"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, calc.FormatCurrencyLocale(tt.value, tt.symbol, tt.decimal, tt.grouper), "value %v", tt.value)
+	}
+}
+
+func TestFormatCurrencyLocaleSameSeparators(t *testing.T) {
+	// Grouping is dropped, so the output still parses back
+	formatted := NewCalculator().FormatCurrencyLocale(1234567.5, "", ',', ',')
+	assert.Equal(t, "1234567,50", formatted)
+	parsed, err := ParseNumber(formatted, ',', 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1234567.5, parsed)
+}
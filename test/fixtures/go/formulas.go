@@ -0,0 +1,110 @@
+package calculator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// EvalNamed evaluates every formula against vars and returns the results by
+// formula name. A formula may refer to another formula's result by name, as
+// if it were a variable holding that rounded result, so formulas are
+// evaluated after the ones they depend on. A cycle of references is rejected
+// with ErrInvalidArgument naming the cycle, as is a formula named like one of
+// the variables.
+func (c *Calculator) EvalNamed(formulas map[string]string, vars map[string]float64) (map[string]float64, error) {
+	if err := validateNames("variable", vars); err != nil {
+		return nil, err
+	}
+	if err := validateNames("formula", formulas); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(formulas))
+	deps := make(map[string][]string, len(formulas))
+	for name, expr := range formulas {
+		if _, clash := vars[name]; clash {
+			return nil, fmt.Errorf("%w: formula %q has the same name as a variable", ErrInvalidArgument, name)
+		}
+		refs, err := formulaRefs(expr, formulas)
+		if err != nil {
+			return nil, fmt.Errorf("formula %q: %w", name, err)
+		}
+		names = append(names, name)
+		deps[name] = refs
+	}
+	sort.Strings(names)
+
+	env := make(map[string]float64, len(vars)+len(formulas))
+	for name, value := range vars {
+		env[name] = value
+	}
+	results := make(map[string]float64, len(formulas))
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(formulas))
+	var path []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			start := 0
+			for path[start] != name {
+				start++
+			}
+			cycle := append(path[start:len(path):len(path)], name)
+			return fmt.Errorf("%w: formula cycle %s", ErrInvalidArgument, strings.Join(cycle, " -> "))
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range deps[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		value, err := c.eval(&parser{ctx: context.Background(), vars: env}, formulas[name])
+		if err != nil {
+			return fmt.Errorf("formula %q: %w", name, err)
+		}
+		env[name] = value
+		results[name] = value
+		path = path[:len(path)-1]
+		state[name] = done
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// formulaRefs returns, sorted and without duplicates, the names of formulas
+// that expr uses as variables
+func formulaRefs(expr string, formulas map[string]string) ([]string, error) {
+	tokens, err := tokenize(context.Background(), expr)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var refs []string
+	for i, tok := range tokens {
+		if tok.kind != tokIdent || tokens[i+1].kind == tokLParen || seen[tok.text] {
+			continue
+		}
+		if _, ok := formulas[tok.text]; ok {
+			seen[tok.text] = true
+			refs = append(refs, tok.text)
+		}
+	}
+	sort.Strings(refs)
+	return refs, nil
+}
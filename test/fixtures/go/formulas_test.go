@@ -0,0 +1,77 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvalNamedIndependent(t *testing.T) {
+	calc := NewCalculator()
+	results, err := calc.EvalNamed(map[string]string{
+		"discounted": "price * (1 - discount/100)",
+		"tax":        "price * rate",
+		"constant":   "42",
+	}, map[string]float64{"price": 80, "discount": 25, "rate": 0.2})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"discounted": 60, "tax": 16, "constant": 42}, results)
+}
+
+func TestEvalNamedReferences(t *testing.T) {
+	calc := NewCalculator()
+	results, err := calc.EvalNamed(map[string]string{
+		"total":    "subtotal + tax",
+		"tax":      "subtotal * 0.2",
+		"subtotal": "price * qty",
+	}, map[string]float64{"price": 9.99, "qty": 3})
+	require.NoError(t, err)
+	assert.Equal(t, 29.97, results["subtotal"])
+	assert.Equal(t, 5.99, results["tax"])
+	assert.Equal(t, 35.96, results["total"])
+}
+
+func TestEvalNamedCycle(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.EvalNamed(map[string]string{
+		"a": "b + 1",
+		"b": "c * 2",
+		"c": "a - 3",
+		"d": "1",
+	}, nil)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "formula cycle a -> b -> c -> a")
+
+	_, err = calc.EvalNamed(map[string]string{"self": "self + 1"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Contains(t, err.Error(), "self -> self")
+}
+
+func TestEvalNamedErrors(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.EvalNamed(map[string]string{"x": "price * 2"}, map[string]float64{"price": 1, "x": 2})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = calc.EvalNamed(map[string]string{"ok": "1", "bad": "missing + 1"}, nil)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Contains(t, err.Error(), `formula "bad"`)
+	assert.Contains(t, parseErr.Msg, `undefined variable "missing"`)
+
+	_, err = calc.EvalNamed(map[string]string{"div": "1 / zero"}, map[string]float64{"zero": 0})
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+
+	_, err = calc.EvalNamed(map[string]string{"1st": "1"}, nil)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestEvalNamedFunctionCallIsNotAReference(t *testing.T) {
+	calc := NewCalculator()
+	calc.RegisterFunc("double", func(args ...float64) (float64, error) { return 2 * args[0], nil })
+	results, err := calc.EvalNamed(map[string]string{
+		"double": "double(x)",
+	}, map[string]float64{"x": 4})
+	require.NoError(t, err)
+	assert.Equal(t, 8.0, results["double"])
+}
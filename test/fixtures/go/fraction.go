@@ -0,0 +1,51 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// maxFractionMagnitude bounds |x| in ToFraction so numerators fit in an int
+const maxFractionMagnitude = 1 << 53
+
+// ToFraction returns the fraction closest to x whose denominator is at most
+// maxDenominator, found by walking the continued-fraction expansion of x and
+// checking the last semiconvergent. The denominator is always positive, so
+// the sign is carried by the numerator.
+func ToFraction(x float64, maxDenominator int) (numerator, denominator int, err error) {
+	if !isFinite(x) {
+		return 0, 0, ErrNotFinite
+	}
+	if maxDenominator < 1 {
+		return 0, 0, fmt.Errorf("%w: max denominator must be positive", ErrInvalidArgument)
+	}
+	if math.Abs(x) >= maxFractionMagnitude {
+		return 0, 0, ErrOverflow
+	}
+
+	// h/k are successive convergents, starting from the conventional 0/1, 1/0
+	h0, k0, h1, k1 := 0, 1, 1, 0
+	rest := x
+	for {
+		a := math.Floor(rest)
+		if a*float64(k1)+float64(k0) > float64(maxDenominator) {
+			break
+		}
+		ai := int(a)
+		h0, h1 = h1, ai*h1+h0
+		k0, k1 = k1, ai*k1+k0
+		frac := rest - a
+		if frac == 0 || float64(h1)/float64(k1) == x {
+			return h1, k1, nil
+		}
+		rest = 1 / frac
+	}
+
+	// The largest semiconvergent within the bound may beat the last convergent
+	t := (maxDenominator - k0) / k1
+	hs, ks := h0+t*h1, k0+t*k1
+	if math.Abs(x-float64(hs)/float64(ks)) < math.Abs(x-float64(h1)/float64(k1)) {
+		return hs, ks, nil
+	}
+	return h1, k1, nil
+}
@@ -0,0 +1,69 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToFractionExact(t *testing.T) {
+	tests := []struct {
+		x        float64
+		num, den int
+	}{
+		{0.5, 1, 2},
+		{0.75, 3, 4},
+		{0.1, 1, 10},
+		{-2.5, -5, 2},
+		{3, 3, 1},
+		{0, 0, 1},
+	}
+	for _, tt := range tests {
+		num, den, err := ToFraction(tt.x, 100)
+		require.NoError(t, err)
+		assert.Equal(t, tt.num, num, "numerator of %v", tt.x)
+		assert.Equal(t, tt.den, den, "denominator of %v", tt.x)
+	}
+}
+
+func TestToFractionRepeatingDecimal(t *testing.T) {
+	num, den, err := ToFraction(0.333333, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, 1, num)
+	assert.Equal(t, 3, den)
+
+	num, den, err = ToFraction(-0.142857, 100)
+	require.NoError(t, err)
+	assert.Equal(t, -1, num)
+	assert.Equal(t, 7, den)
+}
+
+func TestToFractionRespectsMaxDenominator(t *testing.T) {
+	num, den, err := ToFraction(math.Pi, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, 355, num)
+	assert.Equal(t, 113, den)
+
+	num, den, err = ToFraction(math.Pi, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 311, num)
+	assert.Equal(t, 99, den)
+
+	num, den, err = ToFraction(math.Pi, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, num)
+	assert.Equal(t, 1, den)
+}
+
+func TestToFractionInvalid(t *testing.T) {
+	_, _, err := ToFraction(math.NaN(), 100)
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, _, err = ToFraction(math.Inf(1), 100)
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, _, err = ToFraction(0.5, 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, _, err = ToFraction(1e20, 100)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
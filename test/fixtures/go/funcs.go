@@ -0,0 +1,78 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// Func is a named function callable from Eval expressions
+type Func func(args ...float64) (float64, error)
+
+// builtinFuncs are available in every Calculator's expressions
+var builtinFuncs = map[string]Func{
+	"sqrt": unaryFunc(func(x float64) (float64, error) {
+		if x < 0 {
+			return 0, ErrNegativeSqrt
+		}
+		return math.Sqrt(x), nil
+	}),
+	"abs": unaryFunc(func(x float64) (float64, error) {
+		return math.Abs(x), nil
+	}),
+	"min": func(args ...float64) (float64, error) {
+		if len(args) == 0 {
+			return 0, ErrEmptyInput
+		}
+		result := args[0]
+		for _, x := range args[1:] {
+			result = math.Min(result, x)
+		}
+		return result, nil
+	},
+	"max": func(args ...float64) (float64, error) {
+		if len(args) == 0 {
+			return 0, ErrEmptyInput
+		}
+		result := args[0]
+		for _, x := range args[1:] {
+			result = math.Max(result, x)
+		}
+		return result, nil
+	},
+}
+
+// RegisterFunc makes fn callable as name(...) from Eval expressions,
+// replacing any built-in or earlier function of the same name. The name
+// must be an identifier (letters, digits and underscores, not starting with
+// a digit) to be callable. Registering a function clears the result cache.
+func (c *Calculator) RegisterFunc(name string, fn func(args ...float64) (float64, error)) {
+	c.mu.Lock()
+	if c.funcs == nil {
+		c.funcs = make(map[string]Func)
+	}
+	c.funcs[name] = fn
+	c.mu.Unlock()
+	if c.cache != nil {
+		c.cache.clear()
+	}
+}
+
+func (c *Calculator) lookupFunc(name string) (Func, bool) {
+	c.mu.RLock()
+	fn, ok := c.funcs[name]
+	c.mu.RUnlock()
+	if ok {
+		return fn, true
+	}
+	fn, ok = builtinFuncs[name]
+	return fn, ok
+}
+
+func unaryFunc(fn func(x float64) (float64, error)) Func {
+	return func(args ...float64) (float64, error) {
+		if len(args) != 1 {
+			return 0, fmt.Errorf("%w: expected 1 argument, got %d", ErrInvalidArgument, len(args))
+		}
+		return fn(args[0])
+	}
+}
@@ -0,0 +1,81 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvalBuiltinFuncs(t *testing.T) {
+	calc := NewCalculator()
+	tests := map[string]float64{
+		"sqrt(16)":          4,
+		"abs(-2.5) * 2":     5,
+		"max(2, 3)":         3,
+		"min(4, -1, 2)":     -1,
+		"sqrt(abs(-9)) + 1": 4,
+		"max(1 + 1, 2 * 3)": 6,
+	}
+	for expr, expected := range tests {
+		result, err := calc.Eval(expr)
+		require.NoError(t, err, expr)
+		assert.Equal(t, expected, result, expr)
+	}
+}
+
+func TestRegisterFunc(t *testing.T) {
+	calc := NewCalculator()
+	calc.RegisterFunc("vat", func(args ...float64) (float64, error) {
+		if len(args) != 1 {
+			return 0, ErrInvalidArgument
+		}
+		return args[0] * 1.2, nil
+	})
+
+	result, err := calc.Eval("vat(100) - 20")
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, result)
+}
+
+func TestRegisterFuncOverridesBuiltin(t *testing.T) {
+	calc := NewCalculator()
+	calc.RegisterFunc("abs", func(args ...float64) (float64, error) {
+		return 42, nil
+	})
+	result, err := calc.Eval("abs(-1)")
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, result)
+
+	other, err := NewCalculator().Eval("abs(-1)")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, other)
+}
+
+func TestEvalUnknownFunc(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Eval("2 + nope(3)")
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 4, parseErr.Pos)
+	assert.Contains(t, parseErr.Msg, `unknown function "nope"`)
+}
+
+func TestEvalFuncErrors(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Eval("sqrt(-4)")
+	assert.ErrorIs(t, err, ErrNegativeSqrt)
+
+	_, err = calc.Eval("sqrt(1, 2)")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = calc.Eval("max()")
+	assert.ErrorIs(t, err, ErrEmptyInput)
+
+	var parseErr *ParseError
+	_, err = calc.Eval("max(1, 2")
+	assert.True(t, errors.As(err, &parseErr))
+	_, err = calc.Eval("sqrt 4")
+	assert.True(t, errors.As(err, &parseErr))
+}
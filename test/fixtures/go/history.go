@@ -0,0 +1,69 @@
+package calculator
+
+import "math"
+
+// MaxHistory is the number of operations a Calculator remembers before
+// discarding the oldest entries
+const MaxHistory = 1000
+
+// Operation records a single calculator operation
+type Operation struct {
+	Name     string
+	Operands []float64
+	Result   float64
+}
+
+// History returns the recorded operations, oldest first
+func (c *Calculator) History() []Operation {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	history := make([]Operation, len(c.history))
+	copy(history, c.history)
+	return history
+}
+
+// ClearHistory discards all recorded operations
+func (c *Calculator) ClearHistory() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.history = nil
+}
+
+func (c *Calculator) record(name string, result float64, operands ...float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.history) >= MaxHistory {
+		c.history = c.history[1:]
+	}
+	c.history = append(c.history, Operation{Name: name, Operands: operands, Result: result})
+}
+
+// forget removes the most recent history entry equal to op, if any. Other
+// operations may have been recorded since, so it searches from the end.
+func (c *Calculator) forget(op Operation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := len(c.history) - 1; i >= 0; i-- {
+		if sameOperation(c.history[i], op) {
+			c.history = append(c.history[:i], c.history[i+1:]...)
+			return
+		}
+	}
+}
+
+func sameOperation(a, b Operation) bool {
+	if a.Name != b.Name || len(a.Operands) != len(b.Operands) || !sameFloat(a.Result, b.Result) {
+		return false
+	}
+	for i := range a.Operands {
+		if !sameFloat(a.Operands[i], b.Operands[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameFloat is == except that NaN matches NaN
+func sameFloat(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
+}
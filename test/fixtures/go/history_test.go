@@ -0,0 +1,80 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryRecordsOrder(t *testing.T) {
+	calc := NewCalculator()
+	calc.Add(2, 3)
+	calc.Multiply(4, 5)
+	_, err := calc.Divide(10, 4)
+	require.NoError(t, err)
+
+	history := calc.History()
+	require.Len(t, history, 3)
+	assert.Equal(t, Operation{Name: "Add", Operands: []float64{2, 3}, Result: 5}, history[0])
+	assert.Equal(t, Operation{Name: "Multiply", Operands: []float64{4, 5}, Result: 20}, history[1])
+	assert.Equal(t, Operation{Name: "Divide", Operands: []float64{10, 4}, Result: 2.5}, history[2])
+}
+
+func TestHistorySkipsFailedOperations(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Divide(1, 0)
+	require.Error(t, err)
+	assert.Empty(t, calc.History())
+}
+
+func TestHistoryIsCapped(t *testing.T) {
+	calc := NewCalculator()
+	for i := 0; i < MaxHistory+10; i++ {
+		calc.Add(float64(i), 0)
+	}
+	history := calc.History()
+	require.Len(t, history, MaxHistory)
+	assert.Equal(t, 10.0, history[0].Result)
+}
+
+func TestClearHistory(t *testing.T) {
+	calc := NewCalculator()
+	calc.Add(1, 1)
+	calc.ClearHistory()
+	assert.Empty(t, calc.History())
+}
+
+func TestChainUndo(t *testing.T) {
+	calc := NewCalculator()
+	chain := calc.Chain(10).Add(5).Multiply(2)
+	assert.Equal(t, 30.0, chain.Result())
+
+	require.Len(t, calc.History(), 2)
+
+	assert.Equal(t, 15.0, chain.Undo().Result())
+	assert.Equal(t, []Operation{{Name: "Add", Operands: []float64{10, 5}, Result: 15}}, calc.History())
+	assert.Equal(t, 10.0, chain.Undo().Result())
+	assert.Empty(t, calc.History())
+	assert.Equal(t, 10.0, chain.Undo().Result())
+}
+
+func TestChainUndoKeepsOtherHistory(t *testing.T) {
+	calc := NewCalculator()
+	chain := calc.Chain(8).Divide(2)
+	calc.Add(1, 1)
+
+	assert.Equal(t, 8.0, chain.Undo().Result())
+	assert.Equal(t, []Operation{{Name: "Add", Operands: []float64{1, 1}, Result: 2}}, calc.History())
+}
+
+func TestChainUndoClearsError(t *testing.T) {
+	calc := NewCalculator()
+	chain := calc.Chain(10).Divide(0)
+	_, err := chain.ResultWithError()
+	require.Error(t, err)
+
+	result, err := chain.Undo().Add(1).ResultWithError()
+	require.NoError(t, err)
+	assert.Equal(t, 11.0, result)
+}
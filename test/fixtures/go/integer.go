@@ -0,0 +1,90 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+)
+
+// GCD returns the greatest common divisor of a and b using the Euclidean
+// algorithm. GCD(0, 0) is 0 and the result is never negative, with one
+// exception: when a and b are each 0 or math.MinInt the true result,
+// -math.MinInt, does not fit in an int and math.MinInt is returned.
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return absInt(a)
+}
+
+// LCM returns the least common multiple of a and b, or ErrOverflow if it does
+// not fit in an int. The result is never negative and LCM(0, n) is 0.
+func LCM(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	x, y := absInt(a/GCD(a, b)), absInt(b)
+	if x < 0 || y < 0 || x > math.MaxInt/y {
+		return 0, ErrOverflow
+	}
+	return x * y, nil
+}
+
+// LCM is like the package-level LCM but follows the calculator's overflow
+// policy, returning math.MaxInt instead of ErrOverflow when it saturates
+func (c *Calculator) LCM(a, b int) (int, error) {
+	result, err := LCM(a, b)
+	if errors.Is(err, ErrOverflow) && c.saturatesOnOverflow() {
+		return math.MaxInt, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	c.record("LCM", float64(result), float64(a), float64(b))
+	return result, nil
+}
+
+// DivMod returns the integer quotient and remainder of a/b. Like Go's / and %
+// operators, the quotient truncates toward zero and the remainder takes the
+// sign of the dividend, so DivMod(-17, 5) is (-3, -2).
+func (c *Calculator) DivMod(a, b int) (quotient, remainder int, err error) {
+	if b == 0 {
+		return 0, 0, &CalcError{Op: "DivMod", A: float64(a), B: float64(b), Err: ErrDivisionByZero}
+	}
+	if a == math.MinInt && b == -1 {
+		return 0, 0, ErrOverflow
+	}
+	return a / b, a % b, nil
+}
+
+// DivideInt returns the floor of a/b as an int, and whether the division was
+// exact, so DivideInt(10, 2) is (5, true) and DivideInt(7, 2) is (3, false).
+// Like DivideDetailed it judges exactness on the operands' decimal values, so
+// 0.3/0.1 is exactly 3.
+func (c *Calculator) DivideInt(a, b float64) (int, bool, error) {
+	if !isFinite(a) || !isFinite(b) {
+		return 0, false, ErrNotFinite
+	}
+	if b == 0 {
+		return 0, false, &CalcError{Op: "DivideInt", A: a, B: b, Err: ErrDivisionByZero}
+	}
+	q := a / b
+	exact := terminatesWithin(a, b, 0)
+	if exact {
+		q = math.Round(q)
+	} else {
+		q = math.Floor(q)
+	}
+	if q >= math.MaxInt || q < math.MinInt {
+		return 0, false, ErrOverflow
+	}
+	return int(q), exact, nil
+}
+
+// absInt returns |n|; for math.MinInt it returns math.MinInt, which callers
+// treat as overflow
+func absInt(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
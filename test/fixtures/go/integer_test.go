@@ -0,0 +1,126 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGCD(t *testing.T) {
+	assert.Equal(t, 6, GCD(12, 18))
+	assert.Equal(t, 6, GCD(-12, 18))
+	assert.Equal(t, 6, GCD(12, -18))
+	assert.Equal(t, 5, GCD(0, 5))
+	assert.Equal(t, 0, GCD(0, 0))
+	assert.Equal(t, 1, GCD(17, 5))
+	assert.Equal(t, 2, GCD(math.MinInt, 6))
+}
+
+func TestGCDMinInt(t *testing.T) {
+	// -math.MinInt overflows, so these are the documented negative results
+	assert.Equal(t, math.MinInt, GCD(math.MinInt, 0))
+	assert.Equal(t, math.MinInt, GCD(0, math.MinInt))
+	assert.Equal(t, math.MinInt, GCD(math.MinInt, math.MinInt))
+
+	_, err := LCM(math.MinInt, math.MinInt)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestLCM(t *testing.T) {
+	result, err := LCM(4, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 12, result)
+
+	result, err = LCM(-4, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 12, result)
+
+	result, err = LCM(0, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 0, result)
+}
+
+func TestLCMOverflow(t *testing.T) {
+	_, err := LCM(math.MaxInt, math.MaxInt-1)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	_, err = LCM(math.MinInt, 3)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestDivMod(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct{ a, b, quotient, remainder int }{
+		{17, 5, 3, 2},
+		{-17, 5, -3, -2},
+		{17, -5, -3, 2},
+		{-17, -5, 3, -2},
+		{4, 5, 0, 4},
+	}
+	for _, tt := range tests {
+		q, r, err := calc.DivMod(tt.a, tt.b)
+		require.NoError(t, err)
+		assert.Equal(t, tt.quotient, q, "DivMod(%d, %d) quotient", tt.a, tt.b)
+		assert.Equal(t, tt.remainder, r, "DivMod(%d, %d) remainder", tt.a, tt.b)
+	}
+}
+
+func TestDivModByZero(t *testing.T) {
+	calc := NewCalculator()
+	_, _, err := calc.DivMod(17, 0)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestDivModOverflow(t *testing.T) {
+	calc := NewCalculator()
+	_, _, err := calc.DivMod(math.MinInt, -1)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
+
+func TestCalculatorLCMOverflowPolicy(t *testing.T) {
+	_, err := NewCalculator().LCM(math.MaxInt, math.MaxInt-1)
+	assert.ErrorIs(t, err, ErrOverflow)
+
+	result, err := NewCalculator(WithSaturateOnOverflow(true)).LCM(math.MaxInt, math.MaxInt-1)
+	require.NoError(t, err)
+	assert.Equal(t, math.MaxInt, result)
+
+	result, err = NewCalculator(WithSaturateOnOverflow(true)).LCM(4, 6)
+	require.NoError(t, err)
+	assert.Equal(t, 12, result)
+}
+
+func TestDivideInt(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		a, b     float64
+		quotient int
+		exact    bool
+	}{
+		{10, 2, 5, true},
+		{7, 2, 3, false},
+		{-7, 2, -4, false},
+		{-10, 2, -5, true},
+		{0.3, 0.1, 3, true},
+		{1, 3, 0, false},
+		{0, 5, 0, true},
+	}
+	for _, tt := range tests {
+		quotient, exact, err := calc.DivideInt(tt.a, tt.b)
+		require.NoError(t, err)
+		assert.Equal(t, tt.quotient, quotient, "%v / %v", tt.a, tt.b)
+		assert.Equal(t, tt.exact, exact, "%v / %v", tt.a, tt.b)
+	}
+}
+
+func TestDivideIntErrors(t *testing.T) {
+	calc := NewCalculator()
+	_, _, err := calc.DivideInt(10, 0)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+	_, _, err = calc.DivideInt(1e300, 1)
+	assert.ErrorIs(t, err, ErrOverflow)
+	_, _, err = calc.DivideInt(math.NaN(), 1)
+	assert.ErrorIs(t, err, ErrNotFinite)
+}
@@ -0,0 +1,48 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// CompoundInterest returns the final amount (principal plus interest) after
+// compounding principal*(1 + rate/n)^(n*years). annualRate is a fraction,
+// e.g. 0.05 for 5%.
+func CompoundInterest(principal, annualRate float64, timesCompoundedPerYear int, years float64) (float64, error) {
+	if principal < 0 {
+		return 0, fmt.Errorf("%w: principal must not be negative", ErrInvalidArgument)
+	}
+	if years < 0 {
+		return 0, fmt.Errorf("%w: years must not be negative", ErrInvalidArgument)
+	}
+	if timesCompoundedPerYear <= 0 {
+		return 0, fmt.Errorf("%w: compounding frequency must be positive", ErrInvalidArgument)
+	}
+	n := float64(timesCompoundedPerYear)
+	return principal * math.Pow(1+annualRate/n, n*years), nil
+}
+
+// SimpleInterest returns the final amount (principal plus interest) earning
+// simple interest, for comparison with CompoundInterest
+func SimpleInterest(principal, annualRate, years float64) float64 {
+	return principal * (1 + annualRate*years)
+}
+
+// LoanPayment returns the fixed monthly payment that repays principal over
+// months, using the standard amortization formula P·r / (1 - (1+r)^-n) with
+// the monthly rate r = annualRate/12. annualRate is a fraction, e.g. 0.06 for
+// 6%; a zero rate splits the principal evenly.
+func LoanPayment(principal, annualRate float64, months int) (float64, error) {
+	if principal < 0 {
+		return 0, fmt.Errorf("%w: principal must not be negative", ErrInvalidArgument)
+	}
+	if months <= 0 {
+		return 0, fmt.Errorf("%w: months must be positive", ErrInvalidArgument)
+	}
+	n := float64(months)
+	if annualRate == 0 {
+		return principal / n, nil
+	}
+	r := annualRate / 12
+	return principal * r / (1 - math.Pow(1+r, -n)), nil
+}
@@ -0,0 +1,61 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompoundInterestMonthly(t *testing.T) {
+	result, err := CompoundInterest(1000, 0.05, 12, 10)
+	require.NoError(t, err)
+	assert.InDelta(t, 1647.01, result, 0.005)
+}
+
+func TestCompoundInterestZeroYears(t *testing.T) {
+	result, err := CompoundInterest(1000, 0.05, 12, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1000.0, result)
+}
+
+func TestCompoundInterestValidation(t *testing.T) {
+	_, err := CompoundInterest(-1, 0.05, 12, 10)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = CompoundInterest(1000, 0.05, 0, 10)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = CompoundInterest(1000, 0.05, 12, -1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestSimpleInterest(t *testing.T) {
+	assert.InDelta(t, 1500.0, SimpleInterest(1000, 0.05, 10), 1e-9)
+
+	compound, err := CompoundInterest(1000, 0.05, 12, 10)
+	require.NoError(t, err)
+	assert.Greater(t, compound, SimpleInterest(1000, 0.05, 10))
+}
+
+func TestLoanPayment(t *testing.T) {
+	payment, err := LoanPayment(10000, 0.06, 12)
+	require.NoError(t, err)
+	assert.InDelta(t, 860.66, payment, 0.005)
+
+	// A 30-year mortgage of 200000 at 4.5%
+	payment, err = LoanPayment(200000, 0.045, 360)
+	require.NoError(t, err)
+	assert.InDelta(t, 1013.37, payment, 0.005)
+}
+
+func TestLoanPaymentZeroRate(t *testing.T) {
+	payment, err := LoanPayment(1200, 0, 12)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, payment)
+}
+
+func TestLoanPaymentValidation(t *testing.T) {
+	_, err := LoanPayment(-1, 0.06, 12)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = LoanPayment(10000, 0.06, 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
@@ -0,0 +1,18 @@
+package calculator
+
+// Lerp returns the value a fraction t of the way from a to b
+func (c *Calculator) Lerp(a, b, t float64) float64 {
+	return c.round(a + (b-a)*t)
+}
+
+// InverseLerp returns the fraction of the way value lies from a to b.
+// It returns ErrDivisionByZero when a equals b.
+func (c *Calculator) InverseLerp(a, b, value float64) (float64, error) {
+	if err := c.checkOperands(a, b, value); err != nil {
+		return 0, err
+	}
+	if a == b {
+		return 0, ErrDivisionByZero
+	}
+	return c.round((value - a) / (b - a)), nil
+}
@@ -0,0 +1,34 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLerp(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 2.5, calc.Lerp(0, 10, 0.25))
+	assert.Equal(t, 0.0, calc.Lerp(0, 10, 0))
+	assert.Equal(t, 10.0, calc.Lerp(0, 10, 1))
+	assert.Equal(t, 15.0, calc.Lerp(10, 20, 0.5))
+	assert.Equal(t, 12.0, calc.Lerp(0, 10, 1.2))
+}
+
+func TestInverseLerp(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.InverseLerp(0, 10, 2.5)
+	require.NoError(t, err)
+	assert.Equal(t, 0.25, result)
+
+	result, err = calc.InverseLerp(10, 20, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, result)
+}
+
+func TestInverseLerpEqualBounds(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.InverseLerp(5, 5, 5)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
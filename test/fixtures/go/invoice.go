@@ -0,0 +1,18 @@
+package calculator
+
+import "fmt"
+
+// LineTotal returns unitPrice times quantity rounded to the cent with the
+// calculator's rounding mode. The product is rounded once, at the end, so a
+// line of 3 at 0.1 is 0.30.
+func (c *Calculator) LineTotal(unitPrice float64, quantity int) (float64, error) {
+	if quantity < 0 {
+		return 0, fmt.Errorf("%w: quantity must not be negative", ErrInvalidArgument)
+	}
+	if err := c.checkOperands(unitPrice); err != nil {
+		return 0, err
+	}
+	result := Round(unitPrice*float64(quantity), 2, c.RoundingMode())
+	c.record("LineTotal", result, unitPrice, float64(quantity))
+	return result, nil
+}
@@ -0,0 +1,42 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLineTotal(t *testing.T) {
+	calc := NewCalculator(WithPrecision(4))
+	price := 0.1
+	assert.NotEqual(t, 0.3, price*3, "naive multiplication drifts")
+
+	total, err := calc.LineTotal(price, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0.3, total)
+	assert.Equal(t, "0.30", NewCalculator().Format(total))
+
+	total, err = calc.LineTotal(19.99, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 139.93, total)
+
+	total, err = calc.LineTotal(4.125, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 4.13, total)
+
+	total, err = calc.LineTotal(9.99, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, total)
+}
+
+func TestLineTotalRoundingMode(t *testing.T) {
+	total, err := NewCalculator(WithRoundingMode(RoundHalfEven)).LineTotal(4.125, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 4.12, total)
+}
+
+func TestLineTotalNegativeQuantity(t *testing.T) {
+	_, err := NewCalculator().LineTotal(10, -1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
@@ -0,0 +1,52 @@
+package calculator
+
+import "math"
+
+// Ln returns the natural logarithm of x
+func (c *Calculator) Ln(x float64) (float64, error) {
+	if err := c.checkOperands(x); err != nil {
+		return 0, err
+	}
+	if x <= 0 {
+		return 0, ErrNonPositiveLog
+	}
+	result := c.round(math.Log(x))
+	c.record("Ln", result, x)
+	return result, nil
+}
+
+// Log10 returns the base-10 logarithm of x
+func (c *Calculator) Log10(x float64) (float64, error) {
+	if err := c.checkOperands(x); err != nil {
+		return 0, err
+	}
+	if x <= 0 {
+		return 0, ErrNonPositiveLog
+	}
+	result := c.round(math.Log10(x))
+	c.record("Log10", result, x)
+	return result, nil
+}
+
+// LogBase returns the logarithm of x in the given base
+func (c *Calculator) LogBase(x, base float64) (float64, error) {
+	if err := c.checkOperands(x, base); err != nil {
+		return 0, err
+	}
+	if x <= 0 {
+		return 0, ErrNonPositiveLog
+	}
+	if base <= 0 || base == 1 {
+		return 0, ErrInvalidLogBase
+	}
+	result := c.round(math.Log(x) / math.Log(base))
+	c.record("LogBase", result, x, base)
+	return result, nil
+}
+
+// Exp returns e raised to the power x
+func (c *Calculator) Exp(x float64) float64 {
+	result := c.round(math.Exp(x))
+	c.record("Exp", result, x)
+	return result
+}
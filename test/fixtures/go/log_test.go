@@ -0,0 +1,60 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLn(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Ln(math.E)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, result)
+
+	result, err = calc.Ln(10)
+	require.NoError(t, err)
+	assert.Equal(t, 2.3, result)
+}
+
+func TestLog10(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Log10(1000)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, result)
+}
+
+func TestLogBase(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.LogBase(8, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, result)
+}
+
+func TestExp(t *testing.T) {
+	calc := NewCalculator(WithPrecision(4))
+	assert.Equal(t, 1.0, calc.Exp(0))
+	assert.Equal(t, 2.7183, calc.Exp(1))
+}
+
+func TestLogNonPositive(t *testing.T) {
+	calc := NewCalculator()
+	for _, x := range []float64{0, -1} {
+		_, err := calc.Ln(x)
+		assert.ErrorIs(t, err, ErrNonPositiveLog)
+		_, err = calc.Log10(x)
+		assert.ErrorIs(t, err, ErrNonPositiveLog)
+		_, err = calc.LogBase(x, 2)
+		assert.ErrorIs(t, err, ErrNonPositiveLog)
+	}
+}
+
+func TestLogBaseInvalidBase(t *testing.T) {
+	calc := NewCalculator()
+	for _, base := range []float64{0, -2, 1} {
+		_, err := calc.LogBase(8, base)
+		assert.ErrorIs(t, err, ErrInvalidLogBase)
+	}
+}
@@ -0,0 +1,51 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ApplyMarkup adds a markup percentage on top of cost, the sell-side
+// counterpart of ApplyDiscount
+func ApplyMarkup(cost, markupPercent float64) (float64, error) {
+	if markupPercent < 0 {
+		return 0, fmt.Errorf("%w: markup percent must not be negative", ErrInvalidArgument)
+	}
+	return cost * (1 + markupPercent/100), nil
+}
+
+// MarginFromPrice returns the profit margin as a percentage of the selling price
+func MarginFromPrice(cost, price float64) (float64, error) {
+	if price == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return (price - cost) * 100 / price, nil
+}
+
+// ToPricePoint rounds value up to the nearest price whose fractional part is
+// ending, so with ending 0.99 both 12.40 and 12.99 become 12.99 and 13.00
+// becomes 13.99. An ending outside [0, 1) returns value unchanged. Prices
+// are rounded to at least cents, or to ending's decimal places if it has
+// more, so a precision below 2 cannot lose the ending.
+func (c *Calculator) ToPricePoint(value, ending float64) float64 {
+	if !(ending >= 0 && ending < 1) {
+		return value
+	}
+	cfg := c.Config()
+	places := max(cfg.Precision, 2, decimalPlaces(ending))
+	value = Round(value, places, cfg.RoundingMode)
+	point := Round(math.Floor(value)+ending, places, cfg.RoundingMode)
+	if point < value {
+		point = Round(point+1, places, cfg.RoundingMode)
+	}
+	return point
+}
+
+// decimalPlaces returns the number of digits after the decimal point in the
+// shortest representation of x, so 0.99 has 2 and 0.5 has 1
+func decimalPlaces(x float64) int {
+	_, frac, _ := strings.Cut(strconv.FormatFloat(x, 'f', -1, 64), ".")
+	return len(frac)
+}
@@ -0,0 +1,72 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyMarkup(t *testing.T) {
+	result, err := ApplyMarkup(80, 25)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, result)
+
+	result, err = ApplyMarkup(80, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 80.0, result)
+}
+
+func TestApplyMarkupNegative(t *testing.T) {
+	_, err := ApplyMarkup(80, -5)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestMarginFromPrice(t *testing.T) {
+	result, err := MarginFromPrice(80, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 20.0, result)
+
+	result, err = MarginFromPrice(120, 100)
+	require.NoError(t, err)
+	assert.Equal(t, -20.0, result)
+}
+
+func TestMarginFromPriceZeroPrice(t *testing.T) {
+	_, err := MarginFromPrice(80, 0)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestToPricePoint(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		value, ending, expected float64
+	}{
+		{12.40, 0.99, 12.99},
+		{13.00, 0.99, 13.99},
+		{12.99, 0.99, 12.99},
+		{12.995, 0.99, 13.99},
+		{0, 0.99, 0.99},
+		{12.40, 0.95, 12.95},
+		{12.95, 0.95, 12.95},
+		{12.96, 0.95, 13.95},
+		{7.25, 0, 8},
+		{7, 0, 7},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, calc.ToPricePoint(tt.value, tt.ending), "%v to .%v", tt.value, tt.ending)
+	}
+
+	// Precision 0 must not round the ending away
+	calc = NewCalculator(WithPrecision(0))
+	assert.Equal(t, 12.99, calc.ToPricePoint(12.40, 0.99))
+	assert.Equal(t, 12.99, calc.ToPricePoint(12.60, 0.99))
+	assert.Equal(t, 8.0, calc.ToPricePoint(7.25, 0))
+	assert.Equal(t, 12.995, NewCalculator().ToPricePoint(12.001, 0.995))
+}
+
+func TestToPricePointInvalidEnding(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 12.4, calc.ToPricePoint(12.4, 1))
+	assert.Equal(t, 12.4, calc.ToPricePoint(12.4, -0.01))
+}
@@ -0,0 +1,29 @@
+package calculator
+
+// MemoryAdd adds x to the memory register (M+)
+func (c *Calculator) MemoryAdd(x float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.memory = Round(c.memory+x, c.precision, c.rounding)
+}
+
+// MemorySubtract subtracts x from the memory register (M-)
+func (c *Calculator) MemorySubtract(x float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.memory = Round(c.memory-x, c.precision, c.rounding)
+}
+
+// MemoryRecall returns the value in the memory register (MR)
+func (c *Calculator) MemoryRecall() float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.memory
+}
+
+// MemoryClear resets the memory register to zero (MC)
+func (c *Calculator) MemoryClear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.memory = 0
+}
@@ -0,0 +1,44 @@
+package calculator
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryRegister(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 0.0, calc.MemoryRecall())
+
+	calc.MemoryAdd(10)
+	calc.MemoryAdd(5)
+	assert.Equal(t, 15.0, calc.MemoryRecall())
+
+	calc.MemorySubtract(3)
+	assert.Equal(t, 12.0, calc.MemoryRecall())
+
+	calc.MemoryClear()
+	assert.Equal(t, 0.0, calc.MemoryRecall())
+}
+
+func TestMemoryRoundsToPrecision(t *testing.T) {
+	calc := NewCalculator()
+	calc.MemoryAdd(0.1)
+	calc.MemoryAdd(0.2)
+	assert.Equal(t, 0.3, calc.MemoryRecall())
+}
+
+func TestMemoryConcurrentAdds(t *testing.T) {
+	calc := NewCalculator()
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			calc.MemoryAdd(1)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 100.0, calc.MemoryRecall())
+}
@@ -0,0 +1,15 @@
+package calculator
+
+// Must returns value, panicking if err is non-nil. It is meant for tests and
+// call sites where the error cannot happen, e.g. Must(calc.Divide(10, 2)).
+func Must(value float64, err error) float64 {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// MustDivide is like c.Divide but panics on error
+func MustDivide(c *Calculator, a, b float64) float64 {
+	return Must(c.Divide(a, b))
+}
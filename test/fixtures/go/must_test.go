@@ -0,0 +1,30 @@
+package calculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMustDivide(t *testing.T) {
+	assert.Equal(t, 5.0, MustDivide(NewCalculator(), 10, 2))
+}
+
+func TestMustDividePanics(t *testing.T) {
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, "MustDivide(10, 0) should panic")
+		err, ok := r.(error)
+		require.True(t, ok)
+		assert.True(t, errors.Is(err, ErrDivisionByZero))
+	}()
+	MustDivide(NewCalculator(), 10, 0)
+}
+
+func TestMust(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 4.0, Must(calc.Sqrt(16)))
+	assert.Panics(t, func() { Must(calc.Sqrt(-1)) })
+}
@@ -0,0 +1,77 @@
+package calculator
+
+// Option configures a Calculator created by NewCalculator
+type Option func(*Calculator)
+
+// WithPrecision sets the number of decimal places results are rounded to.
+// Values outside [0, MaxPrecision] are ignored.
+func WithPrecision(p int) Option {
+	return func(c *Calculator) {
+		if p >= 0 && p <= MaxPrecision {
+			c.precision = p
+		}
+	}
+}
+
+// WithRoundingMode sets how results are rounded to the calculator's precision
+func WithRoundingMode(mode RoundingMode) Option {
+	return func(c *Calculator) {
+		c.rounding = mode
+	}
+}
+
+// WithAngleMode sets the unit trigonometric functions use for angles
+func WithAngleMode(mode AngleMode) Option {
+	return func(c *Calculator) {
+		c.angle = mode
+	}
+}
+
+// WithStrictFinite makes every error-returning method that takes float64
+// operands, from Divide and Power to Average, Min, Clamp and RoundToNearest,
+// reject NaN or infinite operands with ErrNotFinite before computing. It has
+// no effect on Add, Subtract and Multiply: they have no error result and
+// propagate NaN and Inf in either mode, while AddChecked and the other Checked
+// variants reject non-finite operands in either mode. Off by default.
+func WithStrictFinite(strict bool) Option {
+	return func(c *Calculator) {
+		c.strict = strict
+	}
+}
+
+// WithSaturateOnOverflow chooses what the calculator's Factorial, LCM and
+// IntPowerChecked do when a result overflows: return ErrOverflow (the
+// default) or saturate to +Inf, or math.MaxInt for LCM.
+func WithSaturateOnOverflow(saturate bool) Option {
+	return func(c *Calculator) {
+		c.saturate = saturate
+	}
+}
+
+// WithRateTable sets the exchange rates Convert uses. The table is copied.
+func WithRateTable(rates RateTable) Option {
+	return func(c *Calculator) {
+		c.rates = rates.clone()
+	}
+}
+
+// WithMaxDepth limits how deeply Eval expressions may nest parentheses,
+// function calls and unary signs before failing with ErrExpressionTooDeep.
+// The default is DefaultMaxDepth; values below 1 are ignored.
+func WithMaxDepth(depth int) Option {
+	return func(c *Calculator) {
+		if depth > 0 {
+			c.maxDepth = depth
+		}
+	}
+}
+
+// WithCache enables a least-recently-used cache of up to size results for
+// Eval, Power and Sqrt. A size of zero or less disables caching.
+func WithCache(size int) Option {
+	return func(c *Calculator) {
+		if size > 0 {
+			c.cache = newLRUCache(size)
+		}
+	}
+}
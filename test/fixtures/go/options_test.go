@@ -0,0 +1,36 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCalculatorDefaults(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 2, calc.Precision())
+	assert.Equal(t, RoundHalfUp, calc.RoundingMode())
+}
+
+func TestWithPrecision(t *testing.T) {
+	calc := NewCalculator(WithPrecision(4))
+	assert.Equal(t, 4, calc.Precision())
+	assert.Equal(t, 0.1235, calc.Add(0.12345, 0))
+}
+
+func TestWithPrecisionOutOfRange(t *testing.T) {
+	assert.Equal(t, 2, NewCalculator(WithPrecision(-1)).Precision())
+	assert.Equal(t, 2, NewCalculator(WithPrecision(MaxPrecision+1)).Precision())
+}
+
+func TestWithRoundingMode(t *testing.T) {
+	calc := NewCalculator(WithRoundingMode(RoundHalfUp))
+	assert.Equal(t, RoundHalfUp, calc.RoundingMode())
+}
+
+func TestCombinedOptions(t *testing.T) {
+	calc := NewCalculator(WithPrecision(0), WithRoundingMode(RoundHalfUp))
+	assert.Equal(t, 0, calc.Precision())
+	assert.Equal(t, RoundHalfUp, calc.RoundingMode())
+	assert.Equal(t, 3.0, calc.Add(1.25, 1.25))
+}
@@ -0,0 +1,60 @@
+package calculator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseNumber parses a number written with the given decimal and thousands
+// separators, e.g. "1,234.56" (decimalSep '.', thousandsSep ',') or
+// "1.234,56" (decimalSep ',', thousandsSep '.'). Thousands separators are
+// only accepted between groups of three digits in the integer part.
+func ParseNumber(s string, decimalSep, thousandsSep rune) (float64, error) {
+	if decimalSep == thousandsSep {
+		return 0, fmt.Errorf("%w: decimal and thousands separators must differ", ErrInvalidArgument)
+	}
+	invalid := func() error { return fmt.Errorf("%w %q", ErrInvalidNumber, s) }
+
+	body := strings.TrimSpace(s)
+	sign := ""
+	if strings.HasPrefix(body, "-") || strings.HasPrefix(body, "+") {
+		sign, body = body[:1], body[1:]
+	}
+	whole, frac, hasFrac := strings.Cut(body, string(decimalSep))
+	if whole == "" && frac == "" {
+		return 0, invalid()
+	}
+	if hasFrac && !allDigits(frac) {
+		return 0, invalid()
+	}
+
+	groups := strings.Split(whole, string(thousandsSep))
+	for i, group := range groups {
+		if !allDigits(group) {
+			return 0, invalid()
+		}
+		if len(groups) > 1 && (group == "" || (i > 0 && len(group) != 3) || len(group) > 3) {
+			return 0, invalid()
+		}
+	}
+
+	normalized := sign + strings.Join(groups, "")
+	if hasFrac {
+		normalized += "." + frac
+	}
+	value, err := strconv.ParseFloat(normalized, 64)
+	if err != nil {
+		return 0, invalid()
+	}
+	return value, nil
+}
+
+func allDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
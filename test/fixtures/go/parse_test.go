@@ -0,0 +1,50 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNumberLocales(t *testing.T) {
+	us, err := ParseNumber("1,234.56", '.', ',')
+	require.NoError(t, err)
+	eu, err := ParseNumber("1.234,56", ',', '.')
+	require.NoError(t, err)
+
+	assert.Equal(t, 1234.56, us)
+	assert.Equal(t, us, eu)
+}
+
+func TestParseNumberVariants(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected float64
+	}{
+		{"42", 42},
+		{"-1,000", -1000},
+		{"+12.5", 12.5},
+		{"1,234,567.891", 1234567.891},
+		{".5", 0.5},
+		{"7.", 7},
+		{" 3.25 ", 3.25},
+	}
+	for _, tt := range tests {
+		result, err := ParseNumber(tt.input, '.', ',')
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.expected, result, tt.input)
+	}
+}
+
+func TestParseNumberInvalid(t *testing.T) {
+	for _, input := range []string{"", "abc", "1.2.3", "12,34.5", "1,,234", ",123", "1e5", "NaN", "1.234,56", "-"} {
+		_, err := ParseNumber(input, '.', ',')
+		assert.ErrorIs(t, err, ErrInvalidNumber, input)
+	}
+}
+
+func TestParseNumberSameSeparators(t *testing.T) {
+	_, err := ParseNumber("1.5", '.', '.')
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
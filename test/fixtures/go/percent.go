@@ -0,0 +1,21 @@
+package calculator
+
+import "math"
+
+// PercentOf returns what percentage part is of whole
+func PercentOf(part, whole float64) (float64, error) {
+	if whole == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return part / whole * 100, nil
+}
+
+// PercentChange returns the percentage change from oldValue to newValue.
+// The result is negative when the value dropped, including for negative
+// starting values, since the change is measured against |oldValue|.
+func PercentChange(oldValue, newValue float64) (float64, error) {
+	if oldValue == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return (newValue - oldValue) / math.Abs(oldValue) * 100, nil
+}
@@ -0,0 +1,40 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentOf(t *testing.T) {
+	result, err := PercentOf(25, 200)
+	require.NoError(t, err)
+	assert.Equal(t, 12.5, result)
+}
+
+func TestPercentOfZeroWhole(t *testing.T) {
+	_, err := PercentOf(5, 0)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestPercentChangeIncrease(t *testing.T) {
+	result, err := PercentChange(80, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 25.0, result)
+}
+
+func TestPercentChangeDecrease(t *testing.T) {
+	result, err := PercentChange(100, 80)
+	require.NoError(t, err)
+	assert.Equal(t, -20.0, result)
+
+	result, err = PercentChange(-50, -100)
+	require.NoError(t, err)
+	assert.Equal(t, -100.0, result)
+}
+
+func TestPercentChangeZeroOldValue(t *testing.T) {
+	_, err := PercentChange(0, 10)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
@@ -0,0 +1,14 @@
+package calculator
+
+import "iter"
+
+// Reduce folds seq into initial with op, left to right, and rounds only the
+// final result to the calculator's precision. An empty sequence yields
+// initial rounded.
+func (c *Calculator) Reduce(seq iter.Seq[float64], initial float64, op func(acc, x float64) float64) float64 {
+	acc := initial
+	for x := range seq {
+		acc = op(acc, x)
+	}
+	return c.round(acc)
+}
@@ -0,0 +1,39 @@
+package calculator
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReduceSum(t *testing.T) {
+	calc := NewCalculator()
+	add := func(acc, x float64) float64 { return acc + x }
+	assert.Equal(t, 0.6, calc.Reduce(slices.Values([]float64{0.1, 0.2, 0.3}), 0, add))
+}
+
+func TestReduceProduct(t *testing.T) {
+	calc := NewCalculator()
+	mul := func(acc, x float64) float64 { return acc * x }
+	assert.Equal(t, 24.0, calc.Reduce(slices.Values([]float64{1, 2, 3, 4}), 1, mul))
+}
+
+func TestReduceLazySequence(t *testing.T) {
+	calc := NewCalculator()
+	squares := func(yield func(float64) bool) {
+		for i := 1; i <= 1000; i++ {
+			if !yield(float64(i * i)) {
+				return
+			}
+		}
+	}
+	largest := func(acc, x float64) float64 { return max(acc, x) }
+	assert.Equal(t, 1e6, calc.Reduce(squares, 0, largest))
+}
+
+func TestReduceEmpty(t *testing.T) {
+	calc := NewCalculator()
+	add := func(acc, x float64) float64 { return acc + x }
+	assert.Equal(t, 42.0, calc.Reduce(slices.Values([]float64(nil)), 42, add))
+}
@@ -0,0 +1,54 @@
+package calculator
+
+import "fmt"
+
+// Request is a serialized two-operand operation such as
+// {"op":"divide","a":10,"b":2}. Op is one of "add", "subtract", "multiply",
+// "divide", "modulo" or "power".
+type Request struct {
+	Op string  `json:"op"`
+	A  float64 `json:"a"`
+	B  float64 `json:"b"`
+}
+
+// Execute runs req and returns its Result. An unsupported Op returns
+// ErrUnknownOperation; operation errors such as ErrDivisionByZero are
+// returned as-is.
+func (c *Calculator) Execute(req Request) (Result, error) {
+	switch req.Op {
+	case "add":
+		return c.AddResult(req.A, req.B), nil
+	case "subtract":
+		return c.SubtractResult(req.A, req.B), nil
+	case "multiply":
+		return c.MultiplyResult(req.A, req.B), nil
+	case "divide":
+		return c.DivideResult(req.A, req.B)
+	case "modulo":
+		value, err := c.Modulo(req.A, req.B)
+		if err != nil {
+			return Result{}, err
+		}
+		return c.result("Modulo", value), nil
+	case "power":
+		value, err := c.Power(req.A, req.B)
+		if err != nil {
+			return Result{}, err
+		}
+		return c.result("Power", value), nil
+	default:
+		return Result{}, fmt.Errorf("%w %q", ErrUnknownOperation, req.Op)
+	}
+}
+
+// ExecuteBatch runs every request and, unlike ApplyOps, does not stop at the
+// first failure. results[i] and errs[i] report reqs[i]: a failed request has
+// a zero Result and a non-nil error, a successful one a nil error.
+func (c *Calculator) ExecuteBatch(reqs []Request) (results []Result, errs []error) {
+	results = make([]Result, len(reqs))
+	errs = make([]error, len(reqs))
+	for i, req := range reqs {
+		results[i], errs[i] = c.Execute(req)
+	}
+	return results, errs
+}
@@ -0,0 +1,99 @@
+package calculator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteAdd(t *testing.T) {
+	var req Request
+	require.NoError(t, json.Unmarshal([]byte(`{"op":"add","a":0.1,"b":0.2}`), &req))
+	assert.Equal(t, Request{Op: "add", A: 0.1, B: 0.2}, req)
+
+	result, err := NewCalculator().Execute(req)
+	require.NoError(t, err)
+	assert.Equal(t, Result{Value: 0.3, Precision: 2, Operation: "Add"}, result)
+
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value":0.3,"precision":2,"operation":"Add"}`, string(data))
+}
+
+func TestExecuteOps(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		op       string
+		expected float64
+	}{
+		{"subtract", 8},
+		{"multiply", 20},
+		{"divide", 5},
+		{"modulo", 0},
+		{"power", 100},
+	}
+	for _, tt := range tests {
+		result, err := calc.Execute(Request{Op: tt.op, A: 10, B: 2})
+		require.NoError(t, err, tt.op)
+		assert.Equal(t, tt.expected, result.Value, tt.op)
+	}
+}
+
+func TestExecuteDivideByZero(t *testing.T) {
+	var req Request
+	require.NoError(t, json.Unmarshal([]byte(`{"op":"divide","a":10,"b":0}`), &req))
+	_, err := NewCalculator().Execute(req)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
+
+func TestExecuteUnknownOp(t *testing.T) {
+	_, err := NewCalculator().Execute(Request{Op: "sqrt", A: 4})
+	assert.ErrorIs(t, err, ErrUnknownOperation)
+	assert.Contains(t, err.Error(), `"sqrt"`)
+}
+
+func TestRequestJSONRoundTrip(t *testing.T) {
+	req := Request{Op: "power", A: 2, B: 10}
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"op":"power","a":2,"b":10}`, string(data))
+
+	var decoded Request
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, req, decoded)
+}
+
+func TestExecuteBatch(t *testing.T) {
+	calc := NewCalculator()
+	reqs := []Request{
+		{Op: "add", A: 1, B: 2},
+		{Op: "divide", A: 10, B: 0},
+		{Op: "multiply", A: 3, B: 4},
+		{Op: "divide", A: 1, B: 0},
+		{Op: "cube", A: 2},
+		{Op: "divide", A: 9, B: 3},
+	}
+	results, errs := calc.ExecuteBatch(reqs)
+	require.Len(t, results, len(reqs))
+	require.Len(t, errs, len(reqs))
+
+	assert.NoError(t, errs[0])
+	assert.Equal(t, 3.0, results[0].Value)
+	assert.ErrorIs(t, errs[1], ErrDivisionByZero)
+	assert.Equal(t, Result{}, results[1])
+	assert.NoError(t, errs[2])
+	assert.Equal(t, 12.0, results[2].Value)
+	assert.ErrorIs(t, errs[3], ErrDivisionByZero)
+	assert.Equal(t, Result{}, results[3])
+	assert.ErrorIs(t, errs[4], ErrUnknownOperation)
+	assert.NoError(t, errs[5])
+	assert.Equal(t, 3.0, results[5].Value)
+}
+
+func TestExecuteBatchEmpty(t *testing.T) {
+	results, errs := NewCalculator().ExecuteBatch(nil)
+	assert.Empty(t, results)
+	assert.Empty(t, errs)
+}
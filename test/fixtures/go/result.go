@@ -0,0 +1,36 @@
+package calculator
+
+// Result is a rounded calculation result suitable for JSON encoding
+type Result struct {
+	Value     float64 `json:"value"`
+	Precision int     `json:"precision"`
+	Operation string  `json:"operation,omitempty"`
+}
+
+// AddResult returns the sum of two numbers as a Result
+func (c *Calculator) AddResult(a, b float64) Result {
+	return c.result("Add", c.Add(a, b))
+}
+
+// SubtractResult returns the difference of two numbers as a Result
+func (c *Calculator) SubtractResult(a, b float64) Result {
+	return c.result("Subtract", c.Subtract(a, b))
+}
+
+// MultiplyResult returns the product of two numbers as a Result
+func (c *Calculator) MultiplyResult(a, b float64) Result {
+	return c.result("Multiply", c.Multiply(a, b))
+}
+
+// DivideResult returns the quotient of two numbers as a Result
+func (c *Calculator) DivideResult(a, b float64) (Result, error) {
+	value, err := c.Divide(a, b)
+	if err != nil {
+		return Result{}, err
+	}
+	return c.result("Divide", value), nil
+}
+
+func (c *Calculator) result(op string, value float64) Result {
+	return Result{Value: value, Precision: c.Precision(), Operation: op}
+}
@@ -0,0 +1,35 @@
+package calculator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddResultJSON(t *testing.T) {
+	calc := NewCalculator()
+	data, err := json.Marshal(calc.AddResult(0.1, 0.2))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value":0.3,"precision":2,"operation":"Add"}`, string(data))
+}
+
+func TestResultOmitsEmptyOperation(t *testing.T) {
+	data, err := json.Marshal(Result{Value: 1.5, Precision: 1})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"value":1.5,"precision":1}`, string(data))
+}
+
+func TestResultMethods(t *testing.T) {
+	calc := NewCalculator(WithPrecision(3))
+	assert.Equal(t, Result{Value: 1, Precision: 3, Operation: "Subtract"}, calc.SubtractResult(3, 2))
+	assert.Equal(t, Result{Value: 6, Precision: 3, Operation: "Multiply"}, calc.MultiplyResult(3, 2))
+
+	result, err := calc.DivideResult(2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, Result{Value: 0.667, Precision: 3, Operation: "Divide"}, result)
+
+	_, err = calc.DivideResult(1, 0)
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+}
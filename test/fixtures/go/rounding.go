@@ -0,0 +1,144 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode controls how results are rounded to the calculator's precision
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds ties toward positive infinity
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds ties to the nearest even digit (banker's rounding)
+	RoundHalfEven
+	// RoundFloor always rounds toward negative infinity
+	RoundFloor
+	// RoundCeil always rounds toward positive infinity
+	RoundCeil
+	// RoundHalfAwayFromZero rounds ties away from zero, so -2.5 becomes -3
+	// where RoundHalfUp gives -2
+	RoundHalfAwayFromZero
+)
+
+// String returns the mode's name, e.g. "half-up"
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfUp:
+		return "half-up"
+	case RoundHalfEven:
+		return "half-even"
+	case RoundFloor:
+		return "floor"
+	case RoundCeil:
+		return "ceil"
+	case RoundHalfAwayFromZero:
+		return "half-away-from-zero"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(m))
+	}
+}
+
+// round rounds x to the calculator's precision using its rounding mode
+func (c *Calculator) round(x float64) float64 {
+	c.mu.RLock()
+	places, mode := c.precision, c.rounding
+	c.mu.RUnlock()
+	return Round(x, places, mode)
+}
+
+// RoundToNearest rounds value to the closest multiple of multiple (e.g. 0.05
+// for Swiss rounding) using the calculator's rounding mode
+func (c *Calculator) RoundToNearest(value, multiple float64) (float64, error) {
+	if err := c.checkOperands(value, multiple); err != nil {
+		return 0, err
+	}
+	if multiple == 0 {
+		return 0, fmt.Errorf("%w: multiple must not be zero", ErrInvalidArgument)
+	}
+	steps := Round(value/multiple, 0, c.RoundingMode())
+	return c.round(steps * multiple), nil
+}
+
+// RoundToPrecisionOf rounds value with other's precision and rounding mode,
+// e.g. to show a result from a high-precision calculator at display precision
+func (c *Calculator) RoundToPrecisionOf(value float64, other *Calculator) float64 {
+	return other.round(value)
+}
+
+// FloorTo rounds value toward negative infinity at the given number of
+// decimal places, regardless of the calculator's rounding mode
+func (c *Calculator) FloorTo(value float64, places int) float64 {
+	return Round(value, places, RoundFloor)
+}
+
+// CeilTo rounds value toward positive infinity at the given number of
+// decimal places, regardless of the calculator's rounding mode
+func (c *Calculator) CeilTo(value float64, places int) float64 {
+	return Round(value, places, RoundCeil)
+}
+
+// Round rounds value to the given number of decimal places using mode.
+// This is the same rounding a Calculator applies to its results. Negative
+// zero, including a tiny negative value that rounds away, becomes 0.
+func Round(value float64, places int, mode RoundingMode) float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+	pow := math.Pow10(places)
+	scaled := value * pow
+	// Values this large have no fractional digits left to round
+	if math.Abs(scaled) >= 1<<52 {
+		return value
+	}
+	// Drop binary representation noise (e.g. 2.3*100 = 229.99999999999997)
+	// so that ties and integers are recognised as such. Only a value within a
+	// few ULPs of its 15-digit form is snapped, so a genuine 16th significant
+	// digit survives.
+	snapped, _ := strconv.ParseFloat(strconv.FormatFloat(scaled, 'g', 15, 64), 64)
+	if diff := math.Abs(snapped - scaled); diff <= 4*ulp(scaled) && diff < 0.5 {
+		scaled = snapped
+	}
+
+	switch mode {
+	case RoundHalfEven:
+		scaled = math.RoundToEven(scaled)
+	case RoundFloor:
+		scaled = math.Floor(scaled)
+		if scaled/pow > value && !isFloatNoise(value) {
+			scaled--
+		}
+	case RoundCeil:
+		scaled = math.Ceil(scaled)
+		if scaled/pow < value && !isFloatNoise(value) {
+			scaled++
+		}
+	case RoundHalfAwayFromZero:
+		scaled = math.Round(scaled)
+	default:
+		scaled = math.Floor(scaled + 0.5)
+	}
+	// Report a result that rounds to zero as +0 so it never formats as "-0.00"
+	if scaled == 0 {
+		return 0
+	}
+	return scaled / pow
+}
+
+// isFloatNoise reports whether value needs all 17 significant digits to
+// round-trip, as sums like 0.1+0.2 do. Snapping such a value is what Round
+// is for, so Floor and Ceil may land past it. A value with 16 or fewer, like
+// 2.999999999999999, was meant as written and keeps its side of the result.
+func isFloatNoise(value float64) bool {
+	mantissa, _, _ := strings.Cut(strconv.FormatFloat(math.Abs(value), 'e', -1, 64), "e")
+	return len(strings.Replace(mantissa, ".", "", 1)) >= 17
+}
+
+// ulp returns the gap between |x| and the next larger float64
+func ulp(x float64) float64 {
+	x = math.Abs(x)
+	return math.Nextafter(x, math.Inf(1)) - x
+}
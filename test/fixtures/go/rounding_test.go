@@ -0,0 +1,236 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundingModes(t *testing.T) {
+	inputs := []float64{2.5, 3.5, -2.5, 2.4, -2.4, 2.6}
+	tests := []struct {
+		name     string
+		mode     RoundingMode
+		expected []float64
+	}{
+		{"half-up", RoundHalfUp, []float64{3, 4, -2, 2, -2, 3}},
+		{"half-even", RoundHalfEven, []float64{2, 4, -2, 2, -2, 3}},
+		{"floor", RoundFloor, []float64{2, 3, -3, 2, -3, 2}},
+		{"ceil", RoundCeil, []float64{3, 4, -2, 3, -2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calc := NewCalculator(WithPrecision(0), WithRoundingMode(tt.mode))
+			for i, in := range inputs {
+				assert.Equal(t, tt.expected[i], calc.Add(in, 0), "input %v", in)
+			}
+		})
+	}
+}
+
+func TestRoundingIgnoresRepresentationNoise(t *testing.T) {
+	floor := NewCalculator(WithRoundingMode(RoundFloor))
+	assert.Equal(t, 2.3, floor.Add(2.3, 0))
+
+	halfUp := NewCalculator()
+	assert.Equal(t, 1.01, halfUp.Add(1.005, 0))
+}
+
+func TestRoundToNearest(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct{ value, multiple, expected float64 }{
+		{2.07, 0.05, 2.05},
+		{2.08, 0.05, 2.10},
+		{-2.07, 0.05, -2.05},
+		{-2.08, 0.05, -2.10},
+		{12, 5, 10},
+		{13, 5, 15},
+		{-13, 5, -15},
+	}
+	for _, tt := range tests {
+		result, err := calc.RoundToNearest(tt.value, tt.multiple)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, result, "RoundToNearest(%v, %v)", tt.value, tt.multiple)
+	}
+}
+
+func TestRoundToNearestUsesRoundingMode(t *testing.T) {
+	calc := NewCalculator(WithRoundingMode(RoundFloor))
+	result, err := calc.RoundToNearest(2.09, 0.05)
+	require.NoError(t, err)
+	assert.Equal(t, 2.05, result)
+}
+
+func TestRoundToNearestZeroMultiple(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.RoundToNearest(2.07, 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		value    float64
+		places   int
+		mode     RoundingMode
+		expected float64
+	}{
+		{2.5, 0, RoundHalfUp, 3},
+		{2.5, 0, RoundHalfEven, 2},
+		{2.5, 0, RoundFloor, 2},
+		{2.5, 0, RoundCeil, 3},
+		{3.5, 0, RoundHalfEven, 4},
+		{-2.5, 0, RoundHalfUp, -2},
+		{-2.5, 0, RoundHalfEven, -2},
+		{-2.5, 0, RoundFloor, -3},
+		{-2.5, 0, RoundCeil, -2},
+		{0.125, 2, RoundHalfUp, 0.13},
+		{0.125, 2, RoundHalfEven, 0.12},
+		{0.135, 2, RoundHalfEven, 0.14},
+		{0.125, 2, RoundFloor, 0.12},
+		{0.125, 2, RoundCeil, 0.13},
+		{-0.125, 2, RoundHalfUp, -0.12},
+		{-0.125, 2, RoundHalfEven, -0.12},
+		{-0.125, 2, RoundFloor, -0.13},
+		{-0.125, 2, RoundCeil, -0.12},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, Round(tt.value, tt.places, tt.mode), "Round(%v, %d, %v)", tt.value, tt.places, tt.mode)
+	}
+}
+
+func TestCalculatorRoundingMatchesRound(t *testing.T) {
+	calc := NewCalculator(WithPrecision(3), WithRoundingMode(RoundHalfEven))
+	for _, v := range []float64{1.0005, 2.0015, -3.14159, 0.1 + 0.2} {
+		assert.Equal(t, Round(v, 3, RoundHalfEven), calc.Add(v, 0))
+	}
+}
+
+func TestRoundingModeString(t *testing.T) {
+	assert.Equal(t, "half-up", RoundHalfUp.String())
+	assert.Equal(t, "half-even", RoundHalfEven.String())
+	assert.Equal(t, "floor", RoundFloor.String())
+	assert.Equal(t, "ceil", RoundCeil.String())
+	assert.Equal(t, "RoundingMode(9)", RoundingMode(9).String())
+}
+
+func TestFloorToCeilTo(t *testing.T) {
+	calc := NewCalculator(WithRoundingMode(RoundHalfEven))
+	tests := []struct {
+		value       float64
+		places      int
+		floor, ceil float64
+	}{
+		{2.349, 2, 2.34, 2.35},
+		{2.341, 2, 2.34, 2.35},
+		{2.34, 2, 2.34, 2.34},
+		{-2.341, 2, -2.35, -2.34},
+		{0, 2, 0, 0},
+		{2.5, 0, 2, 3},
+		{-2.5, 0, -3, -2},
+		{0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.floor, calc.FloorTo(tt.value, tt.places), "FloorTo(%v, %d)", tt.value, tt.places)
+		assert.Equal(t, tt.ceil, calc.CeilTo(tt.value, tt.places), "CeilTo(%v, %d)", tt.value, tt.places)
+	}
+}
+
+func TestRoundNormalizesNegativeZero(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	assert.False(t, math.Signbit(Round(negZero, 2, RoundHalfUp)))
+	assert.False(t, math.Signbit(Round(-1e-300, 2, RoundHalfEven)))
+	assert.False(t, math.Signbit(Round(-0.001, 2, RoundCeil)))
+	assert.False(t, math.Signbit(Round(-0.4, 0, RoundHalfUp)))
+
+	calc := NewCalculator(WithRoundingMode(RoundCeil))
+	assert.Equal(t, "0.00", calc.Format(calc.Subtract(0.1, 0.1)))
+	result := calc.Subtract(0.1, 0.1000001)
+	assert.Equal(t, 0.0, result)
+	assert.False(t, math.Signbit(result))
+	assert.Equal(t, "0.00", calc.Format(result))
+	assert.Equal(t, "0.00", NewCalculator().Format(negZero))
+}
+
+func TestRoundToPrecisionOf(t *testing.T) {
+	precise := NewCalculator(WithPrecision(6))
+	display := NewCalculator(WithPrecision(2), WithRoundingMode(RoundHalfEven))
+
+	value, err := precise.Divide(2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 0.666667, value)
+	assert.Equal(t, 0.67, precise.RoundToPrecisionOf(value, display))
+	assert.Equal(t, 0.12, precise.RoundToPrecisionOf(0.125, display))
+	assert.Equal(t, 0.125, display.RoundToPrecisionOf(0.125, precise))
+}
+
+func TestRoundHalfAwayFromZero(t *testing.T) {
+	tests := []struct {
+		value            float64
+		halfUp, awayZero float64
+	}{
+		{-2.5, -2, -3},
+		{-0.5, 0, -1},
+		{2.5, 3, 3},
+		{0.5, 1, 1},
+		{-2.4, -2, -2},
+		{-2.6, -3, -3},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.halfUp, Round(tt.value, 0, RoundHalfUp), "half-up %v", tt.value)
+		assert.Equal(t, tt.awayZero, Round(tt.value, 0, RoundHalfAwayFromZero), "away from zero %v", tt.value)
+	}
+	assert.Equal(t, -1.23, Round(-1.225, 2, RoundHalfAwayFromZero))
+	assert.Equal(t, "half-away-from-zero", RoundHalfAwayFromZero.String())
+}
+
+// Values a caller could write in at most 16 digits are never crossed
+func TestRoundDirectionalNeverCrossesValue(t *testing.T) {
+	tests := []struct {
+		value       float64
+		places      int
+		floor, ceil float64
+	}{
+		{2.999999999999999, 2, 2.99, 3},
+		{2.999999999999999, 0, 2, 3},
+		{math.Nextafter(1, 0), 0, 0, 1},
+		{-2.999999999999999, 2, -3, -2.99},
+		{-3.000000000000001, 2, -3.01, -3},
+		{2.3, 2, 2.3, 2.3},
+		{0.29, 2, 0.29, 0.29},
+	}
+	for _, tt := range tests {
+		floor := Round(tt.value, tt.places, RoundFloor)
+		ceil := Round(tt.value, tt.places, RoundCeil)
+		assert.Equal(t, tt.floor, floor, "floor %v at %d", tt.value, tt.places)
+		assert.Equal(t, tt.ceil, ceil, "ceil %v at %d", tt.value, tt.places)
+		assert.LessOrEqual(t, floor, tt.value)
+		assert.GreaterOrEqual(t, ceil, tt.value)
+	}
+	calc := NewCalculator()
+	assert.Equal(t, 2.99, calc.FloorTo(2.999999999999999, 2))
+}
+
+func TestDirectionalModesDropFloatNoise(t *testing.T) {
+	for _, mode := range []RoundingMode{RoundFloor, RoundCeil} {
+		calc := NewCalculator(WithRoundingMode(mode))
+		assert.Equal(t, 0.3, calc.Add(0.1, 0.2), "%s", mode)
+		assert.Equal(t, 0.2, calc.Subtract(0.3, 0.1), "%s", mode)
+		assert.Equal(t, 1.21, calc.Multiply(1.1, 1.1), "%s", mode)
+		total, err := calc.LineTotal(0.1, 3)
+		require.NoError(t, err)
+		assert.Equal(t, 0.3, total, "%s", mode)
+	}
+	// One ULP above 1 is noise too, unlike 0.9999999999999999 below it
+	assert.Equal(t, 1.0, Round(math.Nextafter(1, 2), 0, RoundCeil))
+}
+
+func TestRoundKeepsSixteenthDigit(t *testing.T) {
+	assert.Equal(t, 1.234567890123456, Round(1.234567890123456, 15, RoundHalfUp))
+	assert.Equal(t, 1.234567890123456, NewCalculator(WithPrecision(15)).Add(1.234567890123456, 0))
+	// Noise is still dropped, so decimal ties round as written
+	assert.Equal(t, 1.01, Round(1.005, 2, RoundHalfUp))
+	assert.Equal(t, 2.3, Round(2.3, 2, RoundFloor))
+}
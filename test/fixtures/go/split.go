@@ -0,0 +1,39 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// SplitWithRemainder splits total into parts amounts of whole cents that sum
+// exactly to total rounded to the cent. The leftover cents go one each to the
+// first parts, so 100 into 3 is 33.34, 33.33, 33.33.
+func SplitWithRemainder(total float64, parts int) ([]float64, error) {
+	if parts <= 0 {
+		return nil, fmt.Errorf("%w: parts must be positive", ErrInvalidArgument)
+	}
+	if !isFinite(total) {
+		return nil, ErrNotFinite
+	}
+	scaled := Round(total, 2, RoundHalfUp) * 100
+	if math.Abs(scaled) >= 1<<53 {
+		return nil, ErrOverflow
+	}
+	cents := int64(math.Round(scaled))
+	base, remainder := cents/int64(parts), cents%int64(parts)
+
+	// remainder takes the sign of total, so negative totals spread -1 cents
+	step := int64(1)
+	if remainder < 0 {
+		step, remainder = -1, -remainder
+	}
+	split := make([]float64, parts)
+	for i := range split {
+		share := base
+		if int64(i) < remainder {
+			share += step
+		}
+		split[i] = float64(share) / 100
+	}
+	return split, nil
+}
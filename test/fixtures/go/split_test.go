@@ -0,0 +1,53 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sumCents(parts []float64) int64 {
+	var total int64
+	for _, p := range parts {
+		total += int64(math.Round(p * 100))
+	}
+	return total
+}
+
+func TestSplitWithRemainder(t *testing.T) {
+	parts, err := SplitWithRemainder(100, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{33.34, 33.33, 33.33}, parts)
+	assert.Equal(t, int64(10000), sumCents(parts))
+}
+
+func TestSplitWithRemainderSumsExactly(t *testing.T) {
+	for _, tt := range []struct {
+		total float64
+		parts int
+	}{
+		{0.1, 3}, {99.99, 7}, {0.01, 5}, {1234.56, 12}, {50, 1}, {-100, 3},
+	} {
+		parts, err := SplitWithRemainder(tt.total, tt.parts)
+		require.NoError(t, err)
+		require.Len(t, parts, tt.parts)
+		assert.Equal(t, int64(math.Round(tt.total*100)), sumCents(parts), "%v into %d", tt.total, tt.parts)
+	}
+}
+
+func TestSplitWithRemainderNegative(t *testing.T) {
+	parts, err := SplitWithRemainder(-100, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{-33.34, -33.33, -33.33}, parts)
+}
+
+func TestSplitWithRemainderInvalid(t *testing.T) {
+	_, err := SplitWithRemainder(100, 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = SplitWithRemainder(math.NaN(), 3)
+	assert.ErrorIs(t, err, ErrNotFinite)
+	_, err = SplitWithRemainder(1e300, 3)
+	assert.ErrorIs(t, err, ErrOverflow)
+}
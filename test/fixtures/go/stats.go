@@ -0,0 +1,267 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// Sum returns the sum of values, or 0 for an empty slice
+func (c *Calculator) Sum(values []float64) float64 {
+	return c.round(sum(values))
+}
+
+// SumParallel returns the sum of values like Sum, splitting the slice across
+// workers goroutines and combining their partial sums. workers <= 0 uses
+// GOMAXPROCS. Because the additions happen in a different order, the result
+// may differ from Sum by floating-point rounding before precision is applied.
+func (c *Calculator) SumParallel(values []float64, workers int) float64 {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(values) {
+		workers = len(values)
+	}
+	if workers <= 1 {
+		return c.Sum(values)
+	}
+	partials := make([]float64, workers)
+	chunk := (len(values) + workers - 1) / workers
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start := w * chunk
+		end := min(start+chunk, len(values))
+		if start >= end {
+			break
+		}
+		wg.Add(1)
+		go func(w int, part []float64) {
+			defer wg.Done()
+			partials[w] = sum(part)
+		}(w, values[start:end])
+	}
+	wg.Wait()
+	return c.round(sum(partials))
+}
+
+// SumWithError returns the precision-rounded sum of values together with the
+// rounding error it carries: the rounded sum minus the exact sum of the
+// inputs. A large error suggests using BigCalculator instead. If any value
+// is NaN or infinite there is no exact sum, so the error is NaN.
+func (c *Calculator) SumWithError(values []float64) (sum float64, roundingError float64) {
+	for _, v := range values {
+		if !isFinite(v) {
+			return c.Sum(values), math.NaN()
+		}
+	}
+	exact := new(big.Float).SetPrec(exactSumPrecision)
+	for _, v := range values {
+		exact.Add(exact, big.NewFloat(v))
+	}
+	sum = c.Sum(values)
+	diff := new(big.Float).SetPrec(exactSumPrecision).Sub(big.NewFloat(sum), exact)
+	roundingError, _ = diff.Float64()
+	return sum, roundingError
+}
+
+// exactSumPrecision is enough mantissa bits to add float64 values without loss
+const exactSumPrecision = 2200
+
+// Product returns the product of values, or 1 for an empty slice
+func (c *Calculator) Product(values []float64) float64 {
+	product := 1.0
+	for _, v := range values {
+		product *= v
+	}
+	return c.round(product)
+}
+
+// CumulativeSum returns the running totals of values, where element i is the
+// sum of values[0..i] rounded to precision. The input slice is not modified.
+func (c *Calculator) CumulativeSum(values []float64) []float64 {
+	totals := make([]float64, len(values))
+	running := 0.0
+	for i, v := range values {
+		running += v
+		totals[i] = c.round(running)
+	}
+	return totals
+}
+
+// Average returns the arithmetic mean of values
+func (c *Calculator) Average(values []float64) (float64, error) {
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	return c.round(sum(values) / float64(len(values))), nil
+}
+
+// AverageOr returns the arithmetic mean of values, or fallback unchanged
+// when values is empty
+func (c *Calculator) AverageOr(values []float64, fallback float64) float64 {
+	if len(values) == 0 {
+		return fallback
+	}
+	return c.round(sum(values) / float64(len(values)))
+}
+
+// AverageIgnoreNaN returns the mean of values skipping NaN entries. It
+// returns ErrEmptyInput when values is empty or every entry is NaN.
+func (c *Calculator) AverageIgnoreNaN(values []float64) (float64, error) {
+	total, count := 0.0, 0
+	for _, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		total += v
+		count++
+	}
+	if count == 0 {
+		return 0, ErrEmptyInput
+	}
+	return c.round(total / float64(count)), nil
+}
+
+// WeightedAverage returns the mean of values weighted by weights. Weights
+// must be non-negative and must not all be zero.
+func (c *Calculator) WeightedAverage(values, weights []float64) (float64, error) {
+	if len(values) != len(weights) {
+		return 0, fmt.Errorf("%w: %d values but %d weights", ErrInvalidArgument, len(values), len(weights))
+	}
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if err := c.checkOperands(weights...); err != nil {
+		return 0, err
+	}
+	total, weightSum := 0.0, 0.0
+	for i, w := range weights {
+		if w < 0 {
+			return 0, fmt.Errorf("%w: negative weight %g at index %d", ErrInvalidArgument, w, i)
+		}
+		total += values[i] * w
+		weightSum += w
+	}
+	if weightSum == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return c.round(total / weightSum), nil
+}
+
+// Median returns the middle value of values, averaging the two middle values
+// for an even-length slice. The input slice is not modified.
+func (c *Calculator) Median(values []float64) (float64, error) {
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	sorted := sortedCopy(values)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return c.round(sorted[mid]), nil
+	}
+	return c.round((sorted[mid-1] + sorted[mid]) / 2), nil
+}
+
+// Percentile returns the p-th percentile of values for p in [0, 100],
+// interpolating linearly between the two closest ranks, so p = 50 matches
+// Median. The input slice is not modified.
+func (c *Calculator) Percentile(values []float64, p float64) (float64, error) {
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	if !(p >= 0 && p <= 100) {
+		return 0, fmt.Errorf("%w: percentile %g must be between 0 and 100", ErrInvalidArgument, p)
+	}
+	sorted := sortedCopy(values)
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	if lower == len(sorted)-1 {
+		return c.round(sorted[lower]), nil
+	}
+	frac := rank - float64(lower)
+	return c.round(sorted[lower] + frac*(sorted[lower+1]-sorted[lower])), nil
+}
+
+// Variance returns the population variance of values (divisor n)
+func (c *Calculator) Variance(values []float64) (float64, error) {
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	return c.round(sumSquaredDeviations(values) / float64(len(values))), nil
+}
+
+// SampleVariance returns the sample variance of values (divisor n-1)
+func (c *Calculator) SampleVariance(values []float64) (float64, error) {
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if len(values) < 2 {
+		return 0, ErrInsufficientData
+	}
+	return c.round(sumSquaredDeviations(values) / float64(len(values)-1)), nil
+}
+
+// StdDev returns the population standard deviation of values
+func (c *Calculator) StdDev(values []float64) (float64, error) {
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	return c.round(math.Sqrt(sumSquaredDeviations(values) / float64(len(values)))), nil
+}
+
+// SampleStdDev returns the sample standard deviation of values
+func (c *Calculator) SampleStdDev(values []float64) (float64, error) {
+	if err := c.checkOperands(values...); err != nil {
+		return 0, err
+	}
+	if len(values) < 2 {
+		return 0, ErrInsufficientData
+	}
+	return c.round(math.Sqrt(sumSquaredDeviations(values) / float64(len(values)-1))), nil
+}
+
+func sortedCopy(values []float64) []float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	return sorted
+}
+
+func sumSquaredDeviations(values []float64) float64 {
+	mean := sum(values) / float64(len(values))
+	total := 0.0
+	for _, v := range values {
+		d := v - mean
+		total += d * d
+	}
+	return total
+}
+
+func sum(values []float64) float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
@@ -0,0 +1,321 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSum(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 0.6, calc.Sum([]float64{0.1, 0.2, 0.3}))
+	assert.Equal(t, -2.5, calc.Sum([]float64{1, -4, 0.5}))
+}
+
+func TestSumEmpty(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 0.0, calc.Sum(nil))
+}
+
+func TestSumWithError(t *testing.T) {
+	calc := NewCalculator()
+	values := make([]float64, 10)
+	for i := range values {
+		values[i] = 0.1
+	}
+	sum, roundingError := calc.SumWithError(values)
+	assert.Equal(t, 1.0, sum)
+	assert.NotZero(t, roundingError)
+	assert.InDelta(t, 0, roundingError, 1e-15)
+}
+
+func TestSumWithErrorFromPrecision(t *testing.T) {
+	calc := NewCalculator()
+	sum, roundingError := calc.SumWithError([]float64{0.004, 0.004, 0.004})
+	assert.Equal(t, 0.01, sum)
+	assert.InDelta(t, -0.002, roundingError, 1e-12)
+}
+
+func TestSumWithErrorExact(t *testing.T) {
+	calc := NewCalculator()
+	sum, roundingError := calc.SumWithError([]float64{1.5, 2.25, -0.75})
+	assert.Equal(t, 3.0, sum)
+	assert.Zero(t, roundingError)
+}
+
+func TestSumWithErrorNonFinite(t *testing.T) {
+	calc := NewCalculator()
+	tests := []struct {
+		values []float64
+		sum    float64
+	}{
+		{[]float64{math.NaN()}, math.NaN()},
+		{[]float64{1, math.Inf(1)}, math.Inf(1)},
+		{[]float64{math.Inf(-1)}, math.Inf(-1)},
+		{[]float64{math.Inf(1), math.Inf(-1)}, math.NaN()},
+	}
+	for _, tt := range tests {
+		sum, roundingError := calc.SumWithError(tt.values)
+		if math.IsNaN(tt.sum) {
+			assert.True(t, math.IsNaN(sum), "%v", tt.values)
+		} else {
+			assert.Equal(t, tt.sum, sum, "%v", tt.values)
+		}
+		assert.True(t, math.IsNaN(roundingError), "%v", tt.values)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, -24.0, calc.Product([]float64{2, -3, 4}))
+	assert.Equal(t, 0.01, calc.Product([]float64{0.1, 0.1}))
+}
+
+func TestProductEmpty(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 1.0, calc.Product([]float64{}))
+}
+
+func TestCumulativeSum(t *testing.T) {
+	calc := NewCalculator()
+	values := []float64{0.1, 0.2, 0.3, -1, 2.5}
+	assert.Equal(t, []float64{0.1, 0.3, 0.6, -0.4, 2.1}, calc.CumulativeSum(values))
+	assert.Equal(t, []float64{0.1, 0.2, 0.3, -1, 2.5}, values)
+}
+
+func TestCumulativeSumEmpty(t *testing.T) {
+	calc := NewCalculator()
+	result := calc.CumulativeSum(nil)
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
+
+func TestAverage(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Average([]float64{1, 2, -6})
+	require.NoError(t, err)
+	assert.Equal(t, -1.0, result)
+
+	result, err = calc.Average([]float64{1, 2, 2})
+	require.NoError(t, err)
+	assert.Equal(t, 1.67, result)
+}
+
+func TestAverageEmpty(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Average(nil)
+	assert.ErrorIs(t, err, ErrEmptyInput)
+}
+
+func TestMedianOdd(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Median([]float64{5, 1, 3})
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, result)
+}
+
+func TestMedianEvenDoesNotMutateInput(t *testing.T) {
+	calc := NewCalculator()
+	values := []float64{4, 1, 3, 2}
+	result, err := calc.Median(values)
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, result)
+	assert.Equal(t, []float64{4, 1, 3, 2}, values)
+}
+
+func TestMedianEmpty(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Median(nil)
+	assert.ErrorIs(t, err, ErrEmptyInput)
+}
+
+func TestVariance(t *testing.T) {
+	calc := NewCalculator()
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	result, err := calc.Variance(values)
+	require.NoError(t, err)
+	assert.Equal(t, 4.0, result)
+
+	result, err = calc.SampleVariance(values)
+	require.NoError(t, err)
+	assert.Equal(t, 4.57, result)
+}
+
+func TestStdDev(t *testing.T) {
+	calc := NewCalculator()
+	values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	result, err := calc.StdDev(values)
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, result)
+
+	result, err = calc.SampleStdDev(values)
+	require.NoError(t, err)
+	assert.Equal(t, 2.14, result)
+}
+
+func TestVarianceOddLength(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.Variance([]float64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, 0.67, result)
+}
+
+func TestVarianceErrors(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Variance(nil)
+	assert.ErrorIs(t, err, ErrEmptyInput)
+	_, err = calc.StdDev(nil)
+	assert.ErrorIs(t, err, ErrEmptyInput)
+	_, err = calc.SampleVariance([]float64{1})
+	assert.ErrorIs(t, err, ErrInsufficientData)
+	_, err = calc.SampleStdDev([]float64{1})
+	assert.ErrorIs(t, err, ErrInsufficientData)
+}
+
+func TestAverageIgnoreNaN(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.AverageIgnoreNaN([]float64{1, math.NaN(), 2, math.NaN(), 6})
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, result)
+}
+
+func TestAverageIgnoreNaNAllNaN(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.AverageIgnoreNaN([]float64{math.NaN(), math.NaN()})
+	assert.ErrorIs(t, err, ErrEmptyInput)
+}
+
+func TestAverageIgnoreNaNEmpty(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.AverageIgnoreNaN(nil)
+	assert.ErrorIs(t, err, ErrEmptyInput)
+}
+
+func TestWeightedAverage(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.WeightedAverage([]float64{2, 4}, []float64{1, 3})
+	require.NoError(t, err)
+	assert.Equal(t, 3.5, result)
+}
+
+func TestWeightedAverageErrors(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.WeightedAverage([]float64{1, 2}, []float64{1})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = calc.WeightedAverage(nil, nil)
+	assert.ErrorIs(t, err, ErrEmptyInput)
+
+	_, err = calc.WeightedAverage([]float64{1, 2}, []float64{0, 0})
+	assert.ErrorIs(t, err, ErrDivisionByZero)
+
+	_, err = calc.WeightedAverage([]float64{1, 2}, []float64{2, -1})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestSumParallelMatchesSum(t *testing.T) {
+	calc := NewCalculator()
+	values := make([]float64, 10007)
+	for i := range values {
+		values[i] = float64(i%97) * 0.37
+	}
+	expected := calc.Sum(values)
+	for _, workers := range []int{0, 1, 3, 8, 20000} {
+		assert.InDelta(t, expected, calc.SumParallel(values, workers), 0.01, "workers=%d", workers)
+	}
+}
+
+func TestSumParallelSmallInputs(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 0.0, calc.SumParallel(nil, 4))
+	assert.Equal(t, 2.5, calc.SumParallel([]float64{2.5}, 4))
+	assert.Equal(t, 6.0, calc.SumParallel([]float64{1, 2, 3}, 2))
+}
+
+func benchmarkValues() []float64 {
+	values := make([]float64, 1_000_000)
+	for i := range values {
+		values[i] = float64(i) * 0.001
+	}
+	return values
+}
+
+func BenchmarkSum(b *testing.B) {
+	calc := NewCalculator()
+	values := benchmarkValues()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		calc.Sum(values)
+	}
+}
+
+func BenchmarkSumParallel(b *testing.B) {
+	calc := NewCalculator()
+	values := benchmarkValues()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		calc.SumParallel(values, 0)
+	}
+}
+
+func TestAverageOr(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 2.33, calc.AverageOr([]float64{1, 2, 4}, -1))
+	assert.Equal(t, -1.0, calc.AverageOr(nil, -1))
+	assert.Equal(t, 0.0, calc.AverageOr([]float64{}, 0))
+}
+
+func TestPercentile(t *testing.T) {
+	calc := NewCalculator()
+	values := []float64{15, 20, 35, 40, 50}
+
+	result, err := calc.Percentile(values, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 15.0, result)
+
+	result, err = calc.Percentile(values, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, result)
+
+	result, err = calc.Percentile(values, 40)
+	require.NoError(t, err)
+	assert.Equal(t, 29.0, result)
+
+	result, err = calc.Percentile([]float64{7}, 90)
+	require.NoError(t, err)
+	assert.Equal(t, 7.0, result)
+}
+
+func TestPercentileMatchesMedian(t *testing.T) {
+	calc := NewCalculator()
+	for _, values := range [][]float64{{3, 1, 2}, {4, 1, 3, 2}, {10, -5, 2.5, 8, 0.1, 7}} {
+		median, err := calc.Median(values)
+		require.NoError(t, err)
+		p50, err := calc.Percentile(values, 50)
+		require.NoError(t, err)
+		assert.Equal(t, median, p50, "%v", values)
+	}
+}
+
+func TestPercentileDoesNotMutate(t *testing.T) {
+	values := []float64{3, 1, 2}
+	_, err := NewCalculator().Percentile(values, 75)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{3, 1, 2}, values)
+}
+
+func TestPercentileInvalid(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.Percentile([]float64{1, 2}, 101)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = calc.Percentile([]float64{1, 2}, -1)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = calc.Percentile([]float64{1, 2}, math.NaN())
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = calc.Percentile(nil, 50)
+	assert.ErrorIs(t, err, ErrEmptyInput)
+}
@@ -0,0 +1,82 @@
+package calculator
+
+import (
+	"math"
+	"sync"
+)
+
+// Stats accumulates count, mean, min, max and variance over a stream of
+// values in a single pass using Welford's algorithm, which stays accurate
+// for long streams where a running sum of squares would lose precision.
+// Results are rounded like the Calculator that created it. Stats is safe
+// for concurrent use.
+type Stats struct {
+	calc  *Calculator
+	mu    sync.Mutex
+	count int
+	mean  float64
+	m2    float64 // sum of squared deviations from the current mean
+	min   float64
+	max   float64
+}
+
+// NewStats returns an empty Stats that rounds like c
+func (c *Calculator) NewStats() *Stats {
+	return &Stats{calc: c}
+}
+
+// Push adds x to the stream
+func (s *Stats) Push(x float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.count++
+	if s.count == 1 {
+		s.min, s.max = x, x
+	} else {
+		s.min = math.Min(s.min, x)
+		s.max = math.Max(s.max, x)
+	}
+	delta := x - s.mean
+	s.mean += delta / float64(s.count)
+	s.m2 += delta * (x - s.mean)
+}
+
+// Count returns how many values have been pushed
+func (s *Stats) Count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.count
+}
+
+// Mean returns the arithmetic mean of the values pushed so far, or 0 if
+// there are none
+func (s *Stats) Mean() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calc.round(s.mean)
+}
+
+// Variance returns the population variance (divisor n) of the values pushed
+// so far, matching Calculator.Variance, or 0 if there are none
+func (s *Stats) Variance() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.count == 0 {
+		return 0
+	}
+	return s.calc.round(s.m2 / float64(s.count))
+}
+
+// Min returns the smallest value pushed so far, or 0 if there are none
+func (s *Stats) Min() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calc.round(s.min)
+}
+
+// Max returns the largest value pushed so far, or 0 if there are none
+func (s *Stats) Max() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calc.round(s.max)
+}
@@ -0,0 +1,82 @@
+package calculator
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsMatchesBatch(t *testing.T) {
+	calc := NewCalculator(WithPrecision(6))
+	rng := rand.New(rand.NewSource(1))
+	values := make([]float64, 100000)
+	stats := calc.NewStats()
+	for i := range values {
+		values[i] = rng.NormFloat64()*15 + 1e6
+		stats.Push(values[i])
+	}
+
+	mean, err := calc.Average(values)
+	require.NoError(t, err)
+	variance, err := calc.Variance(values)
+	require.NoError(t, err)
+	minimum, err := calc.Min(values...)
+	require.NoError(t, err)
+	maximum, err := calc.Max(values...)
+	require.NoError(t, err)
+
+	assert.Equal(t, len(values), stats.Count())
+	assert.InDelta(t, mean, stats.Mean(), 1e-5)
+	assert.InDelta(t, variance, stats.Variance(), 1e-3)
+	assert.Equal(t, minimum, stats.Min())
+	assert.Equal(t, maximum, stats.Max())
+}
+
+func TestStatsSmallStream(t *testing.T) {
+	stats := NewCalculator().NewStats()
+	for _, x := range []float64{2, 4, 4, 4, 5, 5, 7, 9} {
+		stats.Push(x)
+	}
+	assert.Equal(t, 8, stats.Count())
+	assert.Equal(t, 5.0, stats.Mean())
+	assert.Equal(t, 4.0, stats.Variance())
+	assert.Equal(t, 2.0, stats.Min())
+	assert.Equal(t, 9.0, stats.Max())
+}
+
+func TestStatsEmpty(t *testing.T) {
+	stats := NewCalculator().NewStats()
+	assert.Equal(t, 0, stats.Count())
+	assert.Equal(t, 0.0, stats.Mean())
+	assert.Equal(t, 0.0, stats.Variance())
+	assert.Equal(t, 0.0, stats.Min())
+	assert.Equal(t, 0.0, stats.Max())
+}
+
+func TestStatsNegativeValues(t *testing.T) {
+	stats := NewCalculator().NewStats()
+	stats.Push(-3)
+	stats.Push(-1)
+	assert.Equal(t, -3.0, stats.Min())
+	assert.Equal(t, -1.0, stats.Max())
+	assert.Equal(t, -2.0, stats.Mean())
+}
+
+func TestStatsConcurrent(t *testing.T) {
+	stats := NewCalculator().NewStats()
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(x float64) {
+			defer wg.Done()
+			stats.Push(x)
+		}(float64(i))
+	}
+	wg.Wait()
+	assert.Equal(t, 100, stats.Count())
+	assert.Equal(t, 49.5, stats.Mean())
+	assert.Equal(t, 99.0, stats.Max())
+}
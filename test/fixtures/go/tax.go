@@ -0,0 +1,20 @@
+package calculator
+
+// ApplyTax adds a tax percentage on top of a price.
+// A negative tax percent leaves the price unchanged.
+func ApplyTax(price, taxPercent float64) float64 {
+	if taxPercent < 0 {
+		return price
+	}
+	return price * (1 + taxPercent/100)
+}
+
+// PriceWithTaxAfterDiscount discounts the price first and then taxes the
+// discounted amount, so tax is charged on what the customer actually pays.
+// An invalid discount or a negative tax percent leaves the price unchanged.
+func PriceWithTaxAfterDiscount(price, discountPercent, taxPercent float64) float64 {
+	if !validDiscount(discountPercent) || taxPercent < 0 {
+		return price
+	}
+	return ApplyTax(ApplyDiscount(price, discountPercent), taxPercent)
+}
@@ -0,0 +1,31 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyTax(t *testing.T) {
+	assert.InDelta(t, 108.0, ApplyTax(100, 8), 1e-9)
+	assert.Equal(t, 100.0, ApplyTax(100, 0))
+}
+
+func TestApplyTaxNegativePercent(t *testing.T) {
+	assert.Equal(t, 100.0, ApplyTax(100, -8))
+}
+
+func TestPriceWithTaxAfterDiscount(t *testing.T) {
+	assert.InDelta(t, 97.2, PriceWithTaxAfterDiscount(100, 10, 8), 1e-9)
+}
+
+func TestPriceWithTaxAfterDiscountTaxesDiscountedPrice(t *testing.T) {
+	discounted := ApplyDiscount(100, 10)
+	tax := PriceWithTaxAfterDiscount(100, 10, 8) - discounted
+	assert.InDelta(t, 7.2, tax, 1e-9)
+}
+
+func TestPriceWithTaxAfterDiscountInvalidPercents(t *testing.T) {
+	assert.Equal(t, 100.0, PriceWithTaxAfterDiscount(100, -10, 8))
+	assert.Equal(t, 100.0, PriceWithTaxAfterDiscount(100, 10, -8))
+}
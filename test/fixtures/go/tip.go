@@ -0,0 +1,28 @@
+package calculator
+
+import "fmt"
+
+// TipAmount returns tipPercent percent of bill
+func TipAmount(bill, tipPercent float64) (float64, error) {
+	if bill < 0 {
+		return 0, ErrNegativePrice
+	}
+	if tipPercent < 0 {
+		return 0, fmt.Errorf("%w: tip percent must not be negative", ErrInvalidArgument)
+	}
+	return bill * tipPercent / 100, nil
+}
+
+// SplitBill returns each person's share of bill plus a tipPercent tip,
+// rounded up to the cent so that the shares together never fall short of
+// the total
+func SplitBill(bill, tipPercent float64, people int) (perPerson float64, err error) {
+	if people <= 0 {
+		return 0, fmt.Errorf("%w: people must be positive", ErrInvalidArgument)
+	}
+	tip, err := TipAmount(bill, tipPercent)
+	if err != nil {
+		return 0, err
+	}
+	return Round((bill+tip)/float64(people), 2, RoundCeil), nil
+}
@@ -0,0 +1,54 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTipAmount(t *testing.T) {
+	tip, err := TipAmount(100, 18)
+	require.NoError(t, err)
+	assert.InDelta(t, 18.0, tip, 1e-9)
+
+	tip, err = TipAmount(50, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, tip)
+}
+
+func TestTipAmountInvalid(t *testing.T) {
+	_, err := TipAmount(100, -5)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = TipAmount(-100, 18)
+	assert.ErrorIs(t, err, ErrNegativePrice)
+}
+
+func TestSplitBillCoversTotal(t *testing.T) {
+	share, err := SplitBill(100, 18, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 39.34, share)
+	assert.GreaterOrEqual(t, share*3, 118.0)
+}
+
+func TestSplitBillEvenSplit(t *testing.T) {
+	share, err := SplitBill(90, 0, 3)
+	require.NoError(t, err)
+	assert.Equal(t, 30.0, share)
+
+	share, err = SplitBill(100, 20, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 30.0, share)
+
+	// 1.1 plus a 10% tip is 1.21; float noise must not round it up a cent
+	share, err = SplitBill(1.1, 10, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1.21, share)
+}
+
+func TestSplitBillInvalid(t *testing.T) {
+	_, err := SplitBill(100, 18, 0)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = SplitBill(100, -1, 2)
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
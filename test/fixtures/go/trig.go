@@ -0,0 +1,94 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// AngleMode selects the unit trigonometric functions use for angles
+type AngleMode int
+
+const (
+	// Radians interprets angles in radians, matching the math package
+	Radians AngleMode = iota
+	// Degrees interprets angles in degrees
+	Degrees
+)
+
+// String returns the mode's name, "radians" or "degrees"
+func (m AngleMode) String() string {
+	switch m {
+	case Radians:
+		return "radians"
+	case Degrees:
+		return "degrees"
+	default:
+		return fmt.Sprintf("AngleMode(%d)", int(m))
+	}
+}
+
+// AngleMode returns the unit trigonometric functions use for angles
+func (c *Calculator) AngleMode() AngleMode {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.angle
+}
+
+// SetAngleMode changes the unit trigonometric functions use for angles
+func (c *Calculator) SetAngleMode(mode AngleMode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.angle = mode
+}
+
+// Sin returns the sine of x
+func (c *Calculator) Sin(x float64) float64 {
+	result := c.round(math.Sin(c.toRadians(x)))
+	c.record("Sin", result, x)
+	return result
+}
+
+// Cos returns the cosine of x
+func (c *Calculator) Cos(x float64) float64 {
+	result := c.round(math.Cos(c.toRadians(x)))
+	c.record("Cos", result, x)
+	return result
+}
+
+// Tan returns the tangent of x. Near odd multiples of 90° the tangent is
+// undefined; because such angles are not exactly representable, Tan returns
+// a very large finite value there instead of an error.
+func (c *Calculator) Tan(x float64) float64 {
+	result := c.round(math.Tan(c.toRadians(x)))
+	c.record("Tan", result, x)
+	return result
+}
+
+// Sinh returns the hyperbolic sine of x. Hyperbolic functions take a real
+// argument rather than an angle, so the angle mode does not apply.
+func (c *Calculator) Sinh(x float64) float64 {
+	result := c.round(math.Sinh(x))
+	c.record("Sinh", result, x)
+	return result
+}
+
+// Cosh returns the hyperbolic cosine of x
+func (c *Calculator) Cosh(x float64) float64 {
+	result := c.round(math.Cosh(x))
+	c.record("Cosh", result, x)
+	return result
+}
+
+// Tanh returns the hyperbolic tangent of x, which approaches ±1 for large |x|
+func (c *Calculator) Tanh(x float64) float64 {
+	result := c.round(math.Tanh(x))
+	c.record("Tanh", result, x)
+	return result
+}
+
+func (c *Calculator) toRadians(x float64) float64 {
+	if c.AngleMode() == Degrees {
+		return DegreesToRadians(x)
+	}
+	return x
+}
@@ -0,0 +1,66 @@
+package calculator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAngleModeDefault(t *testing.T) {
+	assert.Equal(t, Radians, NewCalculator().AngleMode())
+	assert.Equal(t, Degrees, NewCalculator(WithAngleMode(Degrees)).AngleMode())
+}
+
+func TestSinRadians(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 1.0, calc.Sin(math.Pi/2))
+	assert.Equal(t, 0.0, calc.Sin(0))
+}
+
+func TestSinDegrees(t *testing.T) {
+	calc := NewCalculator(WithAngleMode(Degrees))
+	assert.Equal(t, 1.0, calc.Sin(90))
+	assert.Equal(t, 0.5, calc.Sin(30))
+}
+
+func TestCosTan(t *testing.T) {
+	calc := NewCalculator()
+	calc.SetAngleMode(Degrees)
+	assert.Equal(t, 0.5, calc.Cos(60))
+	assert.Equal(t, 1.0, calc.Tan(45))
+	assert.Equal(t, -1.0, calc.Cos(180))
+}
+
+func TestTanNearRightAngle(t *testing.T) {
+	calc := NewCalculator(WithAngleMode(Degrees))
+	result := calc.Tan(90)
+	assert.False(t, math.IsNaN(result))
+	assert.Greater(t, math.Abs(result), 1e15)
+}
+
+func TestHyperbolic(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 0.0, calc.Sinh(0))
+	assert.Equal(t, 1.0, calc.Cosh(0))
+	assert.Equal(t, 0.0, calc.Tanh(0))
+	assert.Equal(t, 1.18, calc.Sinh(1))
+	assert.Equal(t, 1.54, calc.Cosh(1))
+}
+
+func TestTanhSaturates(t *testing.T) {
+	calc := NewCalculator()
+	assert.InDelta(t, 1.0, calc.Tanh(50), 1e-9)
+	assert.InDelta(t, -1.0, calc.Tanh(-50), 1e-9)
+}
+
+func TestHyperbolicIgnoresAngleMode(t *testing.T) {
+	calc := NewCalculator(WithAngleMode(Degrees))
+	assert.Equal(t, 1.18, calc.Sinh(1))
+}
+
+func TestAngleModeString(t *testing.T) {
+	assert.Equal(t, "radians", Radians.String())
+	assert.Equal(t, "degrees", Degrees.String())
+	assert.Equal(t, "AngleMode(5)", AngleMode(5).String())
+}
@@ -0,0 +1,37 @@
+package calculator
+
+import (
+	"fmt"
+	"math"
+)
+
+// DotProduct returns the sum of a[i]*b[i]. The vectors must be non-empty and
+// the same length.
+func (c *Calculator) DotProduct(a, b []float64) (float64, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return 0, ErrEmptyInput
+	}
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("%w: vector lengths %d and %d differ", ErrInvalidArgument, len(a), len(b))
+	}
+	if err := c.checkOperands(a...); err != nil {
+		return 0, err
+	}
+	if err := c.checkOperands(b...); err != nil {
+		return 0, err
+	}
+	total := 0.0
+	for i := range a {
+		total += a[i] * b[i]
+	}
+	return c.round(total), nil
+}
+
+// Magnitude returns the Euclidean norm of v, or 0 for an empty vector
+func (c *Calculator) Magnitude(v []float64) float64 {
+	norm := 0.0
+	for _, x := range v {
+		norm = math.Hypot(norm, x)
+	}
+	return c.round(norm)
+}
@@ -0,0 +1,35 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDotProduct(t *testing.T) {
+	calc := NewCalculator()
+	result, err := calc.DotProduct([]float64{1, 2, 3}, []float64{4, 5, 6})
+	require.NoError(t, err)
+	assert.Equal(t, 32.0, result)
+
+	result, err = calc.DotProduct([]float64{0.1, 0.2}, []float64{0.1, 0.2})
+	require.NoError(t, err)
+	assert.Equal(t, 0.05, result)
+}
+
+func TestDotProductInvalid(t *testing.T) {
+	calc := NewCalculator()
+	_, err := calc.DotProduct([]float64{1, 2}, []float64{1, 2, 3})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = calc.DotProduct(nil, nil)
+	assert.ErrorIs(t, err, ErrEmptyInput)
+}
+
+func TestMagnitude(t *testing.T) {
+	calc := NewCalculator()
+	assert.Equal(t, 5.0, calc.Magnitude([]float64{3, 4}))
+	assert.Equal(t, 1.73, calc.Magnitude([]float64{1, 1, 1}))
+	assert.Equal(t, 0.0, calc.Magnitude(nil))
+	assert.Equal(t, 1e200, calc.Magnitude([]float64{1e200, 1e100}))
+}
@@ -0,0 +1,35 @@
+package calculator
+
+import "sort"
+
+// version is the release of this package reported by Version
+const version = "1.0.0"
+
+// Version returns the package's release version
+func Version() string {
+	return version
+}
+
+// evalOperators are the operators Eval understands, in precedence order
+var evalOperators = []string{"+", "-", "*", "/", "%"}
+
+// SupportedOps lists everything Eval accepts: the operators, followed by the
+// built-in and registered function names in alphabetical order
+func (c *Calculator) SupportedOps() []string {
+	names := make(map[string]bool, len(builtinFuncs))
+	for name := range builtinFuncs {
+		names[name] = true
+	}
+	c.mu.RLock()
+	for name := range c.funcs {
+		names[name] = true
+	}
+	c.mu.RUnlock()
+
+	funcs := make([]string, 0, len(names))
+	for name := range names {
+		funcs = append(funcs, name)
+	}
+	sort.Strings(funcs)
+	return append(append([]string(nil), evalOperators...), funcs...)
+}
@@ -0,0 +1,26 @@
+package calculator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersion(t *testing.T) {
+	assert.Regexp(t, `^\d+\.\d+\.\d+$`, Version())
+}
+
+func TestSupportedOps(t *testing.T) {
+	calc := NewCalculator()
+	ops := calc.SupportedOps()
+	for _, name := range []string{"+", "-", "*", "/", "%", "sqrt", "abs", "min", "max"} {
+		assert.Contains(t, ops, name)
+	}
+	assert.NotContains(t, ops, "double")
+
+	calc.RegisterFunc("double", func(args ...float64) (float64, error) { return 2 * args[0], nil })
+	calc.RegisterFunc("sqrt", func(args ...float64) (float64, error) { return 0, nil })
+	ops = calc.SupportedOps()
+	assert.Contains(t, ops, "double")
+	assert.Equal(t, []string{"+", "-", "*", "/", "%", "abs", "double", "max", "min", "sqrt"}, ops)
+}